@@ -0,0 +1,165 @@
+// Package arrowbatch lemmatizes columns of tokens in Apache Arrow records,
+// Arrow IPC streams and Parquet files, adding lemma and PoS columns, so
+// data pipelines (Spark, Pandas, Polars) lemmatize millions of rows in a
+// single pass. It is a module of its own so the lemmatizer does not
+// depend on Arrow.
+package arrowbatch
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+)
+
+// Columns names the columns read and written
+type Columns struct {
+	// Token is the string column of the forms
+	Token string
+	// POS is the optional string column of the PoS of each token, names
+	// of lemmatizer.POS ("NOUN"). Without it the first reading of each
+	// form is kept, see lemmatizer.Analyze.
+	POS string
+	// Lemma and LemmaPOS are the columns added, "lemma" and "pos" when
+	// empty. Both are null for unknown forms.
+	Lemma    string
+	LemmaPOS string
+}
+
+func (c Columns) lemma() string {
+	if c.Lemma == "" {
+		return "lemma"
+	}
+	return c.Lemma
+}
+
+func (c Columns) lemmaPOS() string {
+	if c.LemmaPOS == "" {
+		return "pos"
+	}
+	return c.LemmaPOS
+}
+
+// stringColumn is a column of strings, String or LargeString
+type stringColumn interface {
+	arrow.Array
+	Value(i int) string
+}
+
+// Schema returns the schema of the records with the lemmas of in
+func Schema(in *arrow.Schema, cols Columns) (*arrow.Schema, error) {
+	if err := checkColumn(in, cols.Token); err != nil {
+		return nil, err
+	}
+	if cols.POS != "" {
+		if err := checkColumn(in, cols.POS); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range []string{cols.lemma(), cols.lemmaPOS()} {
+		if in.HasField(name) {
+			return nil, fmt.Errorf("arrowbatch: the input already has a %q column", name)
+		}
+	}
+	fields := append(append([]arrow.Field(nil), in.Fields()...),
+		arrow.Field{Name: cols.lemma(), Type: arrow.BinaryTypes.String, Nullable: true},
+		arrow.Field{Name: cols.lemmaPOS(), Type: arrow.BinaryTypes.String, Nullable: true},
+	)
+	metadata := in.Metadata()
+	return arrow.NewSchema(fields, &metadata), nil
+}
+
+// checkColumn checks that in has a single string column name
+func checkColumn(in *arrow.Schema, name string) error {
+	indices := in.FieldIndices(name)
+	switch len(indices) {
+	case 0:
+		return fmt.Errorf("arrowbatch: the input has no column %q", name)
+	case 1:
+	default:
+		return fmt.Errorf("arrowbatch: the input has %v columns %q", len(indices), name)
+	}
+	switch t := in.Field(indices[0]).Type.ID(); t {
+	case arrow.STRING, arrow.LARGE_STRING:
+		return nil
+	default:
+		return fmt.Errorf("arrowbatch: column %q is %v, not a string", name, t)
+	}
+}
+
+// LemmatizeRecord returns rec with the lemma and PoS columns of its
+// tokens added. The options apply to every lookup, e.g.
+// lemmatizer.PassthroughUnknown() to keep unknown forms as lemma. The
+// caller releases the record returned.
+func LemmatizeRecord(l *lemmatizer.Lemmatizer, rec arrow.Record, cols Columns, opts ...lemmatizer.LookupOption) (arrow.Record, error) {
+	schema, err := Schema(rec.Schema(), cols)
+	if err != nil {
+		return nil, err
+	}
+	tokens := rec.Column(rec.Schema().FieldIndices(cols.Token)[0]).(stringColumn)
+	var tags stringColumn
+	if cols.POS != "" {
+		tags = rec.Column(rec.Schema().FieldIndices(cols.POS)[0]).(stringColumn)
+	}
+	lemmas := array.NewStringBuilder(memory.DefaultAllocator)
+	defer lemmas.Release()
+	pos := array.NewStringBuilder(memory.DefaultAllocator)
+	defer pos.Release()
+	lemmas.Reserve(tokens.Len())
+	pos.Reserve(tokens.Len())
+	// the same forms come again and again, look each one up once
+	seen := make(map[reading]reading)
+	for i := 0; i < tokens.Len(); i++ {
+		if tokens.IsNull(i) {
+			lemmas.AppendNull()
+			pos.AppendNull()
+			continue
+		}
+		key := reading{lemma: tokens.Value(i)}
+		if tags != nil && tags.IsValid(i) {
+			if key.pos, err = lemmatizer.ParsePOS(tags.Value(i)); err != nil {
+				return nil, fmt.Errorf("arrowbatch: row %v: %v", i, err)
+			}
+		}
+		r, ok := seen[key]
+		if !ok {
+			r = lookup(l, key.lemma, key.pos, opts)
+			seen[key] = r
+		}
+		if r.lemma == "" && r.pos == lemmatizer.NoPOS {
+			lemmas.AppendNull()
+			pos.AppendNull()
+			continue
+		}
+		lemmas.Append(r.lemma)
+		pos.Append(r.pos.String())
+	}
+	lemmaArray, posArray := lemmas.NewArray(), pos.NewArray()
+	defer lemmaArray.Release()
+	defer posArray.Release()
+	columns := append(append([]arrow.Array(nil), rec.Columns()...), lemmaArray, posArray)
+	return array.NewRecord(schema, columns, rec.NumRows()), nil
+}
+
+// reading is a lemma and its PoS, or a form and its PoS as key
+type reading struct {
+	lemma string
+	pos   lemmatizer.POS
+}
+
+// lookup returns the reading of form for pos, the first reading of form
+// without pos, and an empty reading for unknown forms
+func lookup(l *lemmatizer.Lemmatizer, form string, pos lemmatizer.POS, opts []lemmatizer.LookupOption) reading {
+	if pos != lemmatizer.NoPOS {
+		if r := l.Lookup(form, pos, opts...); r.Found {
+			return reading{lemma: r.Lemma, pos: pos}
+		}
+		return reading{}
+	}
+	if analyses := l.Analyze(form, opts...); len(analyses) > 0 {
+		return reading{lemma: analyses[0].Lemma, pos: analyses[0].POS}
+	}
+	return reading{}
+}
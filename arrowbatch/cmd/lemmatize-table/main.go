@@ -0,0 +1,97 @@
+// Command lemmatize-table adds lemma and PoS columns to a Parquet file or
+// an Arrow IPC stream (.arrow, .arrows) of tokens:
+//
+//	lemmatize-table -lang es -in tokens.parquet -column token -out lemmas.parquet
+//	lemmatize-table -lang es -in tokens.arrows -column token -pos-column upos -out -
+//
+// The format is that of the input file, - is stdin or stdout (Arrow
+// IPC only). Interrupting the command stops it and removes the output.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"github.com/lang-ai/simple_lemmatizer/arrowbatch"
+	_ "github.com/lang-ai/simple_lemmatizer/de"
+	_ "github.com/lang-ai/simple_lemmatizer/es"
+	_ "github.com/lang-ai/simple_lemmatizer/fr"
+)
+
+func main() {
+	lang := flag.String("lang", "", "language of the tokens")
+	in := flag.String("in", "", "input Parquet file or Arrow IPC stream, - for an IPC stream on stdin")
+	out := flag.String("out", "", "output file, - for stdout")
+	column := flag.String("column", "token", "column of the tokens")
+	posColumn := flag.String("pos-column", "", "optional column of the PoS of the tokens")
+	lemmaColumn := flag.String("lemma-column", "lemma", "lemma column added")
+	lemmaPOSColumn := flag.String("lemma-pos-column", "pos", "PoS column added")
+	passthrough := flag.Bool("passthrough", false, "use unknown forms as their own lemma instead of null")
+	flag.Parse()
+	if *lang == "" || *in == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	l, err := lemmatizer.Get(*lang)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var opts []lemmatizer.LookupOption
+	if *passthrough {
+		opts = append(opts, lemmatizer.PassthroughUnknown())
+	}
+	cols := arrowbatch.Columns{Token: *column, POS: *posColumn, Lemma: *lemmaColumn, LemmaPOS: *lemmaPOSColumn}
+	parquet := filepath.Ext(*in) == ".parquet"
+	if parquet && *out == "-" {
+		log.Fatal("Parquet output needs a file, not stdout")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *out == "-" {
+		if err := run(ctx, l, *in, os.Stdout, parquet, cols, opts); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = run(ctx, l, *in, f, parquet, cols, opts)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(*out)
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, l *lemmatizer.Lemmatizer, in string, w io.Writer, parquet bool, cols arrowbatch.Columns, opts []lemmatizer.LookupOption) error {
+	if in == "-" {
+		return arrowbatch.LemmatizeIPC(ctx, l, os.Stdin, w, cols, opts...)
+	}
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if parquet {
+		err = arrowbatch.LemmatizeParquet(ctx, l, f, w, cols, opts...)
+	} else {
+		err = arrowbatch.LemmatizeIPC(ctx, l, f, w, cols, opts...)
+	}
+	if err != nil {
+		return fmt.Errorf("%v: %v", in, err)
+	}
+	return nil
+}
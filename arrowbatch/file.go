@@ -0,0 +1,107 @@
+package arrowbatch
+
+import (
+	"context"
+	"io"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/compress"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+)
+
+// BatchSize is the number of rows read from a Parquet file at once
+const BatchSize = 64 * 1024
+
+// recordWriter writes lemmatized records
+type recordWriter interface {
+	Write(rec arrow.Record) error
+}
+
+// lemmatizeRecords writes the records of r with their lemmas to w,
+// stopping when ctx is done
+func lemmatizeRecords(ctx context.Context, l *lemmatizer.Lemmatizer, r array.RecordReader, w recordWriter, cols Columns, opts []lemmatizer.LookupOption) error {
+	for r.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rec, err := LemmatizeRecord(l, r.Record(), cols, opts...)
+		if err != nil {
+			return err
+		}
+		err = w.Write(rec)
+		rec.Release()
+		if err != nil {
+			return err
+		}
+	}
+	if err := r.Err(); err != io.EOF { // the Parquet reader ends with io.EOF
+		return err
+	}
+	return nil
+}
+
+// LemmatizeIPC copies the Arrow IPC stream of r to w with the lemma and
+// PoS columns added, see LemmatizeRecord
+func LemmatizeIPC(ctx context.Context, l *lemmatizer.Lemmatizer, r io.Reader, w io.Writer, cols Columns, opts ...lemmatizer.LookupOption) error {
+	reader, err := ipc.NewReader(r, ipc.WithAllocator(memory.DefaultAllocator))
+	if err != nil {
+		return err
+	}
+	defer reader.Release()
+	schema, err := Schema(reader.Schema(), cols)
+	if err != nil {
+		return err
+	}
+	writer := ipc.NewWriter(w, ipc.WithSchema(schema), ipc.WithAllocator(memory.DefaultAllocator))
+	if err := lemmatizeRecords(ctx, l, reader, writer, cols, opts); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+// LemmatizeParquet copies the Parquet file of r to w with the lemma and
+// PoS columns added, see LemmatizeRecord. Rows are read BatchSize at a
+// time and written Snappy compressed.
+func LemmatizeParquet(ctx context.Context, l *lemmatizer.Lemmatizer, r parquet.ReaderAtSeeker, w io.Writer, cols Columns, opts ...lemmatizer.LookupOption) error {
+	pf, err := file.NewParquetReader(r)
+	if err != nil {
+		return err
+	}
+	defer pf.Close()
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: BatchSize}, memory.DefaultAllocator)
+	if err != nil {
+		return err
+	}
+	in, err := fr.Schema()
+	if err != nil {
+		return err
+	}
+	schema, err := Schema(in, cols)
+	if err != nil {
+		return err
+	}
+	reader, err := fr.GetRecordReader(ctx, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer reader.Release()
+	props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy))
+	// the Parquet writer closes its sink, which is left to the caller
+	sink := struct{ io.Writer }{w}
+	writer, err := pqarrow.NewFileWriter(schema, sink, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return err
+	}
+	if err := lemmatizeRecords(ctx, l, reader, writer, cols, opts); err != nil {
+		writer.Close()
+		return err
+	}
+	return writer.Close()
+}
@@ -0,0 +1,193 @@
+package lemmatizer_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+// The benchmarks look up the words of the fixture texts of each language,
+// one sub-benchmark per language, so changes to the data format (maps,
+// tries, binary) can be compared:
+//
+//	go test -run '^$' -bench . -benchmem
+//	go test -run '^$' -bench 'Pipeline/es' -benchmem -memprofile mem.out
+//	go tool pprof -sample_index=alloc_space mem.out
+
+// benchLanguages are the languages with fixture texts
+var benchLanguages = []string{"es", "fr", "de"}
+
+// benchFixture are the fixture sentences of a language and its default
+// Lemmatizer and Pipeline
+type benchFixture struct {
+	sentences  [][]string
+	words      []string
+	lemmatizer *lemmatizer.Lemmatizer
+	pipeline   *lemmatizer.Pipeline
+}
+
+var (
+	benchMu       sync.Mutex
+	benchFixtures = make(map[string]*benchFixture)
+)
+
+// benchFixtureFor loads the fixtures of lang once
+func benchFixtureFor(b *testing.B, lang string) *benchFixture {
+	benchMu.Lock()
+	defer benchMu.Unlock()
+	if f, ok := benchFixtures[lang]; ok {
+		return f
+	}
+	texts, err := filepath.Glob(filepath.Join("fixtures", lang, "*.txt"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	f := &benchFixture{}
+	for _, text := range texts {
+		content, err := ioutil.ReadFile(text)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, line := range textio.Lines(content) {
+			if tokens := strings.Fields(line); len(tokens) > 0 {
+				f.sentences = append(f.sentences, tokens)
+				f.words = append(f.words, tokens...)
+			}
+		}
+	}
+	if len(f.words) == 0 {
+		b.Fatalf("no fixtures for %v", lang)
+	}
+	if f.lemmatizer, err = lemmatizer.Get(lang); err != nil {
+		b.Fatal(err)
+	}
+	if f.pipeline, err = lemmatizer.GetPipeline(lang); err != nil {
+		b.Fatal(err)
+	}
+	benchFixtures[lang] = f
+	return f
+}
+
+// runLanguages runs fn as a sub-benchmark of each language, reporting
+// allocations
+func runLanguages(b *testing.B, fn func(b *testing.B, f *benchFixture)) {
+	for _, lang := range benchLanguages {
+		b.Run(lang, func(b *testing.B) {
+			f := benchFixtureFor(b, lang)
+			b.ReportAllocs()
+			b.ResetTimer()
+			fn(b, f)
+		})
+	}
+}
+
+func BenchmarkLookup(b *testing.B) {
+	runLanguages(b, func(b *testing.B, f *benchFixture) {
+		for i := 0; i < b.N; i++ {
+			f.lemmatizer.Lemma(f.words[i%len(f.words)], lemmatizer.NOUN)
+		}
+	})
+}
+
+func BenchmarkLookupNormalized(b *testing.B) {
+	runLanguages(b, func(b *testing.B, f *benchFixture) {
+		upper := make([]string, len(f.words))
+		for i, w := range f.words {
+			upper[i] = strings.ToUpper(w)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			f.lemmatizer.Lemma(upper[i%len(upper)], lemmatizer.NOUN)
+		}
+	})
+}
+
+func BenchmarkLemmatizeBytes(b *testing.B) {
+	runLanguages(b, func(b *testing.B, f *benchFixture) {
+		forms := make([][]byte, len(f.words))
+		for i, w := range f.words {
+			forms[i] = []byte(strings.ToUpper(w))
+		}
+		var dst []byte
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			dst, _ = f.lemmatizer.LemmatizeBytes(dst[:0], forms[i%len(forms)], lemmatizer.NOUN)
+		}
+	})
+}
+
+func BenchmarkAnalyze(b *testing.B) {
+	runLanguages(b, func(b *testing.B, f *benchFixture) {
+		for i := 0; i < b.N; i++ {
+			f.lemmatizer.Analyze(f.words[i%len(f.words)])
+		}
+	})
+}
+
+// BenchmarkBatch analyzes every word of the fixtures per iteration
+func BenchmarkBatch(b *testing.B) {
+	runLanguages(b, func(b *testing.B, f *benchFixture) {
+		for i := 0; i < b.N; i++ {
+			for _, w := range f.words {
+				f.lemmatizer.Analyze(w)
+			}
+		}
+	})
+}
+
+// BenchmarkPipeline lemmatizes every sentence of the fixtures per
+// iteration, one at a time
+func BenchmarkPipeline(b *testing.B) {
+	runLanguages(b, func(b *testing.B, f *benchFixture) {
+		for i := 0; i < b.N; i++ {
+			for _, s := range f.sentences {
+				f.pipeline.Lemmatize(s)
+			}
+		}
+	})
+}
+
+// BenchmarkPipelineDocument lemmatizes the fixtures as one document per
+// iteration
+func BenchmarkPipelineDocument(b *testing.B) {
+	runLanguages(b, func(b *testing.B, f *benchFixture) {
+		for i := 0; i < b.N; i++ {
+			f.pipeline.LemmatizeDocument(context.Background(), f.sentences)
+		}
+	})
+}
+
+// BenchmarkLoad builds a Lemmatizer from the registered dictionary and
+// reads a .lemdict file of it
+func BenchmarkLoad(b *testing.B) {
+	for _, lang := range benchLanguages {
+		dictionary := lemmatizer.Dictionary(lang)
+		b.Run(lang+"/New", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				lemmatizer.New(lang, dictionary)
+			}
+		})
+		b.Run(lang+"/Lemdict", func(b *testing.B) {
+			var buf bytes.Buffer
+			if err := lemmatizer.WriteLemdict(&buf, lang, dictionary); err != nil {
+				b.Fatal(err)
+			}
+			b.SetBytes(int64(buf.Len()))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := lemmatizer.ReadLemdict(bytes.NewReader(buf.Bytes())); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Package blevefilter is a Bleve token filter replacing terms with their
+// lemmas, to normalize words at index and query time. It is a module of
+// its own so the lemmatizer does not depend on Bleve.
+//
+// Importing the package registers the filter as "lemmatizer", configured
+// with the language, whose package must be imported too, and whether to
+// index every reading:
+//
+//	"token_filters": {
+//		"lemmas_es": {"type": "lemmatizer", "lang": "es", "readings": "all"}
+//	}
+package blevefilter
+
+import (
+	"fmt"
+
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/registry"
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+)
+
+// Name is the type of the filter in Bleve index mappings
+const Name = "lemmatizer"
+
+// Filter replaces the terms of a token stream with their lemmas. Keyword
+// tokens and unknown words are left as is.
+type Filter struct {
+	lemmatizer *lemmatizer.Lemmatizer
+	// all emits every lemma of ambiguous words at the same position,
+	// otherwise only the first one is kept
+	all bool
+}
+
+// New returns a Filter lemmatizing with l, emitting every lemma of
+// ambiguous words when all is set
+func New(l *lemmatizer.Lemmatizer, all bool) *Filter {
+	return &Filter{lemmatizer: l, all: all}
+}
+
+// Filter implements analysis.TokenFilter
+func (f *Filter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	output := make(analysis.TokenStream, 0, len(input))
+	for _, token := range input {
+		output = append(output, token)
+		if token.KeyWord {
+			continue
+		}
+		analyses := f.lemmatizer.Analyze(string(token.Term))
+		if len(analyses) == 0 {
+			continue
+		}
+		token.Term = []byte(analyses[0].Lemma)
+		if !f.all {
+			continue
+		}
+		seen := map[string]bool{analyses[0].Lemma: true}
+		for _, a := range analyses[1:] {
+			if seen[a.Lemma] {
+				continue
+			}
+			seen[a.Lemma] = true
+			reading := *token
+			reading.Term = []byte(a.Lemma)
+			output = append(output, &reading)
+		}
+	}
+	return output
+}
+
+// Constructor builds the filter from its configuration in an index
+// mapping: "lang", a registered language, and "readings", "first" (the
+// default) or "all"
+func Constructor(config map[string]interface{}, cache *registry.Cache) (analysis.TokenFilter, error) {
+	lang, ok := config["lang"].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: missing lang", Name)
+	}
+	l, err := lemmatizer.Get(lang)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", Name, err)
+	}
+	all := false
+	switch readings, _ := config["readings"].(string); readings {
+	case "", "first":
+	case "all":
+		all = true
+	default:
+		return nil, fmt.Errorf("%s: readings must be first or all, got %q", Name, readings)
+	}
+	return New(l, all), nil
+}
+
+func init() {
+	registry.RegisterTokenFilter(Name, Constructor)
+}
@@ -0,0 +1,20 @@
+module github.com/lang-ai/simple_lemmatizer/blevefilter
+
+go 1.27.1
+
+require (
+	github.com/blevesearch/bleve/v2 v2.3.10
+	github.com/lang-ai/simple_lemmatizer v0.0.0
+)
+
+require (
+	github.com/blevesearch/bleve_index_api v1.0.6 // indirect
+	github.com/blevesearch/geo v0.1.18 // indirect
+	github.com/blevesearch/upsidedown_store_api v1.0.2 // indirect
+	github.com/golang/geo v0.0.0-20210211234256-740aa86cb551 // indirect
+	github.com/json-iterator/go v0.0.0-20171115153421-f7279a603ede // indirect
+	github.com/klauspost/compress v1.16.7 // indirect
+	golang.org/x/text v0.8.0 // indirect
+)
+
+replace github.com/lang-ai/simple_lemmatizer => ../
@@ -0,0 +1,63 @@
+// Command boltdict writes dictionaries to a bbolt database for
+// boltstore, those of registered languages and of .lemdict files:
+//
+//	boltdict -db dictionaries.db -lang es,fr
+//	boltdict -db dictionaries.db ca.lemdict
+//
+// The dictionaries already in the database for the same languages are
+// replaced.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"github.com/lang-ai/simple_lemmatizer/boltstore"
+	_ "github.com/lang-ai/simple_lemmatizer/de"
+	_ "github.com/lang-ai/simple_lemmatizer/es"
+	_ "github.com/lang-ai/simple_lemmatizer/fr"
+)
+
+func main() {
+	path := flag.String("db", "", "database file, created if missing")
+	langs := flag.String("lang", "", "comma separated registered languages to write")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: boltdict -db file [-lang es,fr] [file.lemdict...]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *path == "" || *langs == "" && flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	db, err := boltstore.Create(*path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+	if *langs != "" {
+		for _, lang := range strings.Split(*langs, ",") {
+			dictionary := lemmatizer.Dictionary(lang)
+			if dictionary == nil {
+				log.Fatalf("no language %q, registered are %v", lang, lemmatizer.Languages())
+			}
+			if err := boltstore.Write(db, lang, dictionary); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	for _, file := range flag.Args() {
+		d, err := lemmatizer.LoadLemdict(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := boltstore.Write(db, d.Language, d.Dictionary); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
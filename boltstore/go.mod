@@ -0,0 +1,16 @@
+module github.com/lang-ai/simple_lemmatizer/boltstore
+
+go 1.27.1
+
+require (
+	github.com/lang-ai/simple_lemmatizer v0.0.0
+	go.etcd.io/bbolt v1.3.8
+)
+
+require (
+	github.com/klauspost/compress v1.16.7 // indirect
+	golang.org/x/sys v0.4.0 // indirect
+	golang.org/x/text v0.3.0 // indirect
+)
+
+replace github.com/lang-ai/simple_lemmatizer => ../
@@ -0,0 +1,208 @@
+// Package boltstore is a lemmatizer.DictStore over a bbolt database, so
+// deployments with many languages keep their dictionaries on disk and
+// only page in the forms looked up. A database holds any number of
+// languages: a bucket per language, with a bucket per PoS of forms to
+// lemmas. It is a module of its own so the lemmatizer does not depend on
+// bbolt.
+//
+//	db, err := bbolt.Open("dictionaries.db", 0644, nil)
+//	...
+//	err = boltstore.Write(db, "es", es.Dictionary)
+//	...
+//	s, err := boltstore.Open("dictionaries.db", "es")
+//	...
+//	l := lemmatizer.NewFromStore("es", s, lemmatizer.WithCache(10000))
+package boltstore
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"go.etcd.io/bbolt"
+)
+
+// Store is the dictionary of one language of a bbolt database
+type Store struct {
+	db      *bbolt.DB
+	owned   bool // opened by Open, closed by Close
+	lang    []byte
+	posList []lemmatizer.POS
+
+	mu  sync.Mutex
+	err error
+}
+
+// Open opens the database file read-only and returns the Store of lang
+// in it. Close closes the database.
+func Open(path, lang string) (*Store, error) {
+	db, err := bbolt.Open(path, 0444, &bbolt.Options{ReadOnly: true, Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("boltstore: %v", err)
+	}
+	s, err := New(db, lang)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	s.owned = true
+	return s, nil
+}
+
+// New returns the Store of lang in an open database, which several
+// Stores can share. Closing the database is up to the caller.
+func New(db *bbolt.DB, lang string) (*Store, error) {
+	s := &Store{db: db, lang: []byte(lang)}
+	err := db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(s.lang)
+		if b == nil {
+			return fmt.Errorf("boltstore: no language %q in %v", lang, db.Path())
+		}
+		return b.ForEach(func(name, v []byte) error {
+			if v != nil {
+				return nil // not a bucket
+			}
+			pos, err := lemmatizer.ParsePOS(string(name))
+			if err != nil {
+				return fmt.Errorf("boltstore: %v: %v", lang, err)
+			}
+			s.posList = append(s.posList, pos)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(s.posList, func(i, j int) bool { return s.posList[i] < s.posList[j] })
+	return s, nil
+}
+
+// Get implements lemmatizer.DictStore
+func (s *Store) Get(pos lemmatizer.POS, form string) (string, bool) {
+	var lemma string
+	var ok bool
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := s.bucket(tx, pos)
+		if b == nil {
+			return nil
+		}
+		// the value is only valid in the transaction, string copies it
+		if v := b.Get([]byte(form)); v != nil {
+			lemma, ok = string(v), true
+		}
+		return nil
+	})
+	s.record(err)
+	return lemma, ok
+}
+
+// Range implements lemmatizer.DictStore, in the order of the forms
+func (s *Store) Range(pos lemmatizer.POS, f func(form, lemma string) bool) {
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		b := s.bucket(tx, pos)
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if !f(string(k), string(v)) {
+				return nil
+			}
+		}
+		return nil
+	})
+	s.record(err)
+}
+
+// POS implements lemmatizer.DictStore
+func (s *Store) POS() []lemmatizer.POS {
+	return s.posList
+}
+
+// Err returns the first read error, reported by Get and Range as missing
+// forms
+func (s *Store) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close closes the database when the Store was opened with Open
+func (s *Store) Close() error {
+	if !s.owned {
+		return nil
+	}
+	return s.db.Close()
+}
+
+func (s *Store) bucket(tx *bbolt.Tx, pos lemmatizer.POS) *bbolt.Bucket {
+	b := tx.Bucket(s.lang)
+	if b == nil {
+		return nil
+	}
+	return b.Bucket([]byte(pos.String()))
+}
+
+func (s *Store) record(err error) {
+	if err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Write replaces the dictionary of lang in db (map of PoS to map of Form
+// to Lemma), one transaction per PoS. The forms are written in order and
+// the pages filled up, as the database is read much more than written.
+func Write(db *bbolt.DB, lang string, dictionary map[lemmatizer.POS]map[string]string) error {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		if tx.Bucket([]byte(lang)) != nil {
+			if err := tx.DeleteBucket([]byte(lang)); err != nil {
+				return err
+			}
+		}
+		_, err := tx.CreateBucket([]byte(lang))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("boltstore: %v: %v", lang, err)
+	}
+	for pos, dict := range dictionary {
+		forms := make([]string, 0, len(dict))
+		for form := range dict {
+			forms = append(forms, form)
+		}
+		sort.Strings(forms)
+		err := db.Update(func(tx *bbolt.Tx) error {
+			b, err := tx.Bucket([]byte(lang)).CreateBucket([]byte(pos.String()))
+			if err != nil {
+				return err
+			}
+			b.FillPercent = 1
+			for _, form := range forms {
+				if err := b.Put([]byte(form), []byte(dict[form])); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("boltstore: %v %v: %v", lang, pos, err)
+		}
+	}
+	return nil
+}
+
+// Create creates (or opens) the database file for writing, see Write
+func Create(path string) (*bbolt.DB, error) {
+	db, err := bbolt.Open(path, 0644, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("boltstore: %v", err)
+	}
+	return db, nil
+}
@@ -0,0 +1,107 @@
+package lemmatizer
+
+import (
+	"sync"
+	"unicode/utf8"
+)
+
+// LemmatizeBytes appends the lemma of form for pos to dst, like Lemma.
+// Forms in the dictionary, as is or normalized, are looked up without
+// allocating: reusing dst across calls (dst[:0]) keeps hot loops such as
+// indexing free of garbage. Forms needing the fallbacks, the historical
+// spellings or the inclusive endings, or runes the fast normalization
+// does not cover, take the path of Lemma, as do the PoS with overrides.
+func (l *Lemmatizer) LemmatizeBytes(dst, form []byte, pos POS) ([]byte, bool) {
+	dict := l.dictionary[pos]
+	if dict != nil && l.overrides[pos] == nil && l.singleCharacters == PolicyDefault && l.functionWords == PolicyDefault && (pos != NumPOS || l.numbers == nil) {
+		// map lookups with a converted []byte key do not allocate
+		if lemma, ok := dict[string(form)]; ok {
+			return append(dst, lemma...), true
+		}
+		// the normalized key goes after dst, where the lemma is written
+		// once it is no longer needed
+		if key, ok := l.folds.append(dst[len(dst):], form); ok {
+			if lemma, ok := dict[string(key)]; ok {
+				return append(dst, lemma...), true
+			}
+			if l.historical == nil && l.inclusive == nil && !l.hasFallbacks(mapEntries(dict), pos) {
+				return dst, false
+			}
+		}
+	}
+	lemma, ok := l.Lemma(string(form), pos)
+	if !ok {
+		return dst, false
+	}
+	return append(dst, lemma...), true
+}
+
+// foldLatin bounds the runes of foldTable.latin: ASCII, Latin-1 and the
+// Latin Extended blocks, none of which combines with the previous rune
+const foldLatin = 0x250
+
+// foldTable is the normalized form of single runes, to normalize a form
+// rune by rune without allocating. Normalizing a form is normalizing its
+// runes one by one as long as none is a combining mark.
+type foldTable struct {
+	latin [foldLatin]string
+	// extra are the fullwidth forms and the apostrophes outside latin
+	extra map[rune]string
+}
+
+// foldExtra are the ranges of runes of foldTable.extra
+var foldExtra = [][2]rune{
+	{0x02bc, 0x02bc}, // modifier letter apostrophe
+	{0x2018, 0x2019}, // single quotation marks
+	{0x2032, 0x2032}, // prime
+	{0xff01, 0xff5e}, // fullwidth ASCII
+}
+
+var (
+	foldTablesMu sync.Mutex
+	foldTables   = make(map[Normalizer]*foldTable)
+)
+
+// foldTableFor returns the foldTable of n, built on first use
+func foldTableFor(n Normalizer) *foldTable {
+	foldTablesMu.Lock()
+	defer foldTablesMu.Unlock()
+	if t, ok := foldTables[n]; ok {
+		return t
+	}
+	t := &foldTable{extra: make(map[rune]string)}
+	for r := rune(0); r < foldLatin; r++ {
+		t.latin[r] = n.Normalize(string(r))
+	}
+	for _, runes := range foldExtra {
+		for r := runes[0]; r <= runes[1]; r++ {
+			t.extra[r] = n.Normalize(string(r))
+		}
+	}
+	foldTables[n] = t
+	return t
+}
+
+// append appends the normalized form to buf, reporting false when form
+// has runes the table does not cover
+func (t *foldTable) append(buf, form []byte) ([]byte, bool) {
+	for i := 0; i < len(form); {
+		if b := form[i]; b < utf8.RuneSelf {
+			buf = append(buf, t.latin[b]...)
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(form[i:])
+		var s string
+		if r < foldLatin {
+			s = t.latin[r]
+		} else if folded, ok := t.extra[r]; ok {
+			s = folded
+		} else {
+			return buf, false
+		}
+		buf = append(buf, s...)
+		i += size
+	}
+	return buf, true
+}
@@ -0,0 +1,97 @@
+package lemmatizer
+
+import (
+	"container/list"
+	"sync"
+)
+
+// WithCache keeps the results of the last size fallback lookups
+// (derivations, fuzzy matching) in an LRU cache. Dictionary hits are not
+// cached, they are cheap already.
+func WithCache(size int) Option {
+	return func(l *Lemmatizer) {
+		if size > 0 {
+			l.cache = newLRU(size)
+		}
+	}
+}
+
+// CacheStats are the counters of the fallback cache
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+	// Len is the number of cached entries
+	Len int
+}
+
+// CacheStats returns the counters of the cache set with WithCache, zero
+// without one
+func (l *Lemmatizer) CacheStats() CacheStats {
+	if l.cache == nil {
+		return CacheStats{}
+	}
+	return l.cache.stats()
+}
+
+type cacheKey struct {
+	form string
+	pos  POS
+}
+
+type cacheEntry struct {
+	key    cacheKey
+	result Lookup
+}
+
+// lru is a fixed size cache of lookups, safe for concurrent use
+type lru struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // front is the most recently used
+	entries map[cacheKey]*list.Element
+	hits    uint64
+	misses  uint64
+}
+
+func newLRU(size int) *lru {
+	return &lru{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[cacheKey]*list.Element, size),
+	}
+}
+
+func (c *lru) get(k cacheKey) (Lookup, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[k]
+	if !ok {
+		c.misses++
+		return Lookup{}, false
+	}
+	c.hits++
+	c.order.MoveToFront(e)
+	return e.Value.(*cacheEntry).result, true
+}
+
+func (c *lru) add(k cacheKey, r Lookup) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[k]; ok {
+		e.Value.(*cacheEntry).result = r
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[k] = c.order.PushFront(&cacheEntry{key: k, result: r})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *lru) stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{Hits: c.hits, Misses: c.misses, Len: c.order.Len()}
+}
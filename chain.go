@@ -0,0 +1,109 @@
+package lemmatizer
+
+// LanguageChain looks forms up in the Lemmatizers of several languages in
+// turn, for code-switched texts where the dictionary of one language
+// rejects valid forms of another: Spanish with Catalan place names, say.
+// It is safe for concurrent use.
+type LanguageChain struct {
+	lemmatizers []*Lemmatizer
+}
+
+// Chain returns a LanguageChain over the registered languages langs, in
+// order, as Get returns them
+func Chain(langs ...string) (*LanguageChain, error) {
+	c := &LanguageChain{}
+	for _, lang := range langs {
+		l, err := Get(lang)
+		if err != nil {
+			return nil, err
+		}
+		c.lemmatizers = append(c.lemmatizers, l)
+	}
+	return c, nil
+}
+
+// NewChain returns a LanguageChain over lemmatizers, in order
+func NewChain(lemmatizers ...*Lemmatizer) *LanguageChain {
+	return &LanguageChain{lemmatizers: lemmatizers}
+}
+
+// Languages returns the languages of c, in order
+func (c *LanguageChain) Languages() []string {
+	langs := make([]string, len(c.lemmatizers))
+	for i, l := range c.lemmatizers {
+		langs[i] = l.lang
+	}
+	return langs
+}
+
+// Lemma returns the lemma of form for pos and the language it comes
+// from, see Lookup
+func (c *LanguageChain) Lemma(form string, pos POS, opts ...LookupOption) (string, string, bool) {
+	r, lang := c.Lookup(form, pos, opts...)
+	return r.Lemma, lang, r.Found
+}
+
+// Lookup looks form up for pos in every language before trying the
+// fallbacks of any, so a form of the dictionary of the second language
+// beats a guess of the first one: the first language to find a lemma
+// wins, and its code is returned too, empty when none found it. The
+// lemmas Strict or MinConfidence reject in a language leave it to the
+// next.
+func (c *LanguageChain) Lookup(form string, pos POS, opts ...LookupOption) (Lookup, string) {
+	o := newLookupOptions(opts)
+	if !o.allows(pos) {
+		return o.finishLookup(form, pos, Lookup{}), ""
+	}
+	var rejected Lookup
+	done := make([]bool, len(c.lemmatizers))
+	for i, l := range c.lemmatizers {
+		var r Lookup
+		r, done[i] = l.lookupKnown(form, pos)
+		if r = r.scored(pos); r.Found {
+			if o.accepts(r.Source, r.Confidence) {
+				return o.finishLookup(form, pos, r), l.lang
+			}
+			rejected = r
+		}
+	}
+	for i, l := range c.lemmatizers {
+		if done[i] {
+			continue
+		}
+		r := l.fallback(form, pos, l.deadline()).scored(pos)
+		if r.Found && o.accepts(r.Source, r.Confidence) {
+			return o.finishLookup(form, pos, r), l.lang
+		}
+		if r.Found && !rejected.Found {
+			rejected = r
+		}
+		rejected.Truncated = rejected.Truncated || r.Truncated
+	}
+	return o.finishLookup(form, pos, rejected), ""
+}
+
+// Analyze returns every reading of form in the first language with any,
+// sorted by PoS, and that language, empty when none has a reading. As
+// with Lookup, the fallbacks are only tried when no language has the form
+// in its dictionary.
+func (c *LanguageChain) Analyze(form string, opts ...LookupOption) ([]Analysis, string) {
+	o := newLookupOptions(opts)
+	done := make([]bool, len(c.lemmatizers))
+	for i, l := range c.lemmatizers {
+		var analyses []Analysis
+		analyses, done[i] = l.analyzeExact(form, o)
+		if analyses, _ = o.confident(analyses); len(analyses) > 0 {
+			return o.finish(form, analyses), l.lang
+		}
+	}
+	for i, l := range c.lemmatizers {
+		if done[i] {
+			continue
+		}
+		analyses, _ := l.analyzeFallback(form, o, l.deadline())
+		if analyses, _ = o.confident(analyses); len(analyses) > 0 {
+			return o.finish(form, analyses), l.lang
+		}
+	}
+	return o.finish(form, nil), ""
+}
@@ -0,0 +1,162 @@
+package lemmatizer
+
+import (
+	"regexp"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Classifiers are the token classes recognized by WithClassifiers
+type Classifiers struct {
+	// URLs matches the tokens starting with a scheme or www.
+	// ("https://example.com/a?b=1", "www.example.com")
+	URLs bool
+	// Emails matches the addresses ("ana@example.com")
+	Emails bool
+	// Hashtags and Mentions match the #tags and @names of social media
+	Hashtags bool
+	Mentions bool
+	// Dates matches the numeric dates, day, month and year in any order
+	// of the usual ones ("14/10/2026", "14.10.26", "2026-10-14")
+	Dates bool
+	// Emoji matches the tokens made of emoji and other pictographic
+	// symbols, with their modifiers and joiners ("👍🏽", "🇪🇸")
+	Emoji bool
+}
+
+// AllClassifiers recognizes every token class
+var AllClassifiers = Classifiers{URLs: true, Emails: true, Hashtags: true, Mentions: true, Dates: true, Emoji: true}
+
+// TokenClass is what the classifiers of a Pipeline recognized a token
+// as, see WithClassifiers
+type TokenClass int
+
+const (
+	ClassNone TokenClass = iota
+	ClassURL
+	ClassEmail
+	ClassHashtag
+	ClassMention
+	ClassDate
+	ClassEmoji
+)
+
+var classNames = []string{"", "url", "email", "hashtag", "mention", "date", "emoji"}
+
+// classPOS is the PoS of the tokens of each class
+var classPOS = []POS{NoPOS, X, X, X, X, NUM, SYM}
+
+func (c TokenClass) String() string {
+	if c < 0 || int(c) >= len(classNames) {
+		return "TokenClass(" + strconv.Itoa(int(c)) + ")"
+	}
+	return classNames[c]
+}
+
+// POS returns the PoS of the tokens of class c
+func (c TokenClass) POS() POS {
+	if c < 0 || int(c) >= len(classPOS) {
+		return NoPOS
+	}
+	return classPOS[c]
+}
+
+var (
+	urlPattern     = regexp.MustCompile(`^(?i)([a-z][a-z0-9+.-]*://|www\.)[^\s/?#]+[^\s]*$`)
+	emailPattern   = regexp.MustCompile(`^[\p{L}\p{N}._%+-]+@[\p{L}\p{N}-]+(\.[\p{L}\p{N}-]+)*\.\p{L}{2,}$`)
+	hashtagPattern = regexp.MustCompile(`^#[\p{L}\p{N}_]*\p{L}[\p{L}\p{N}_]*$`)
+	mentionPattern = regexp.MustCompile(`^@[\p{L}\p{N}_]+$`)
+	// datePattern matches day, month and year (or month, day and year),
+	// and ISO dates, with the same separator twice
+	datePattern = regexp.MustCompile(`^(\d{1,2})([/.-])(\d{1,2})([/.-])(\d{4}|\d{2})$|^(\d{4})-(\d{1,2})-(\d{1,2})$`)
+)
+
+// WithClassifiers passes the tokens of the classes of c through as they
+// are, with the PoS of their class and SourceClassified, instead of
+// looking them up: the fallbacks would mangle the URLs, addresses and
+// emoji of social media text. The class is reported in Token.Class.
+func WithClassifiers(c Classifiers) PipelineOption {
+	return func(p *Pipeline) {
+		p.classifiers = &c
+	}
+}
+
+// classify returns the class of form, ClassNone without classifiers
+func (p *Pipeline) classify(form string) TokenClass {
+	if p.classifiers == nil {
+		return ClassNone
+	}
+	return p.classifiers.classify(form)
+}
+
+// classify returns the class of form, ClassNone if it has none of those
+// of c. Forms that are not valid UTF-8 are left to the lookups.
+func (c *Classifiers) classify(form string) TokenClass {
+	if form == "" || !utf8.ValidString(form) {
+		return ClassNone
+	}
+	switch form[0] {
+	case '#':
+		if c.Hashtags && hashtagPattern.MatchString(form) {
+			return ClassHashtag
+		}
+		return ClassNone
+	case '@':
+		if c.Mentions && mentionPattern.MatchString(form) {
+			return ClassMention
+		}
+		return ClassNone
+	}
+	switch {
+	case c.URLs && urlPattern.MatchString(form):
+		return ClassURL
+	case c.Emails && emailPattern.MatchString(form):
+		return ClassEmail
+	case c.Dates && isDate(form):
+		return ClassDate
+	case c.Emoji && isEmoji(form):
+		return ClassEmoji
+	}
+	return ClassNone
+}
+
+// isDate reports whether form is a numeric date whose day and month are
+// in range, in either order
+func isDate(form string) bool {
+	m := datePattern.FindStringSubmatch(form)
+	switch {
+	case m == nil:
+		return false
+	case m[6] != "": // ISO
+		return inRange(m[7], 12) && inRange(m[8], 31)
+	case m[2] != m[4]:
+		return false
+	}
+	return inRange(m[1], 31) && inRange(m[3], 12) || inRange(m[1], 12) && inRange(m[3], 31)
+}
+
+// inRange reports whether the number s is from 1 to max
+func inRange(s string, max int) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 1 && n <= max
+}
+
+// isEmoji reports whether form is made of pictographic symbols, with
+// the modifiers, variation selectors, joiners and tags of emoji
+// sequences. U+FFFD, the replacement of unknown characters, is not one.
+func isEmoji(form string) bool {
+	symbols := 0
+	for _, r := range form {
+		switch {
+		case r == utf8.RuneError:
+			return false
+		case unicode.Is(unicode.So, r):
+			symbols++
+		case r == '\u200d', r == '\ufe0f', r == '\u20e3', r >= 0x1f3fb && r <= 0x1f3ff, r >= 0xe0020 && r <= 0xe007f:
+		default:
+			return false
+		}
+	}
+	return symbols > 0
+}
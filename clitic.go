@@ -0,0 +1,44 @@
+package lemmatizer
+
+// maxClitics is the most clitics stripped from a verb (diciéndoselo has
+// two, se and lo)
+const maxClitics = 3
+
+// WithClitics makes the lookups of verbs that fail strip the enclitic
+// pronouns of the language and retry with the host: the lemma of
+// "dámelo" is dar, that of "diciéndoselo" decir. It has no effect for
+// languages without clitic rules (only Spanish has them).
+func WithClitics() Option {
+	return func(l *Lemmatizer) {
+		if r := RulesFor(l.lang); r != nil {
+			l.clitics = r.clitics
+		}
+	}
+}
+
+// clitic returns the lemma of the host of the normalized key in dict,
+// stripping up to maxClitics clitics
+func (l *Lemmatizer) clitic(dict entries, key string, pos POS, depth int) (string, bool) {
+	for i := range l.clitics {
+		r := &l.clitics[i]
+		if !r.applies(pos) {
+			continue
+		}
+		stem, ok := r.match(key)
+		if !ok {
+			continue
+		}
+		for _, replacement := range r.replacements {
+			host := stem + replacement
+			if lemma, ok := dict.lemma(host); ok {
+				return lemma, true
+			}
+			if depth+1 < maxClitics {
+				if lemma, ok := l.clitic(dict, host, pos, depth+1); ok {
+					return lemma, true
+				}
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,140 @@
+package lemmatizer
+
+import "unicode/utf8"
+
+// WordPolicy says how single-character tokens and function words are
+// analyzed, see WithSingleCharacters and WithFunctionWords
+type WordPolicy int
+
+const (
+	// PolicyDefault analyzes them like any other word
+	PolicyDefault WordPolicy = iota
+	// PolicyNoFallback only looks them up in the dictionary: fuzzy matching
+	// and derivations are slow and never right for "y" or ","
+	PolicyNoFallback
+	// PolicyClosedClass tags them from the closed-class table of the
+	// language, dropping the readings of the dictionary ("a" as the name
+	// of the letter). Words not in the table are treated as with
+	// PolicyNoFallback.
+	PolicyClosedClass
+)
+
+// closedClass are the readings of the function words, by language
+var closedClass = map[string]map[string][]Analysis{
+	"es": {
+		"y":    {{Lemma: "y", POS: CONJ}},
+		"e":    {{Lemma: "y", POS: CONJ}},
+		"o":    {{Lemma: "o", POS: CONJ}},
+		"u":    {{Lemma: "o", POS: CONJ}},
+		"ni":   {{Lemma: "ni", POS: CONJ}},
+		"pero": {{Lemma: "pero", POS: CONJ}},
+		"que":  {{Lemma: "que", POS: CONJ}, {Lemma: "que", POS: PRON}},
+		"a":    {{Lemma: "a", POS: ADP}},
+		"de":   {{Lemma: "de", POS: ADP}},
+		"en":   {{Lemma: "en", POS: ADP}},
+		"con":  {{Lemma: "con", POS: ADP}},
+		"por":  {{Lemma: "por", POS: ADP}},
+		"para": {{Lemma: "para", POS: ADP}},
+		"sin":  {{Lemma: "sin", POS: ADP}},
+		"el":   {{Lemma: "el", POS: DET}},
+		"la":   {{Lemma: "el", POS: DET}, {Lemma: "lo", POS: PRON}},
+		"los":  {{Lemma: "el", POS: DET}, {Lemma: "lo", POS: PRON}},
+		"las":  {{Lemma: "el", POS: DET}, {Lemma: "lo", POS: PRON}},
+		"lo":   {{Lemma: "el", POS: DET}, {Lemma: "lo", POS: PRON}},
+		"un":   {{Lemma: "uno", POS: DET}},
+		"una":  {{Lemma: "uno", POS: DET}, {Lemma: "uno", POS: PRON}},
+		"se":   {{Lemma: "se", POS: PRON}},
+		"me":   {{Lemma: "me", POS: PRON}},
+		"te":   {{Lemma: "te", POS: PRON}},
+		"le":   {{Lemma: "le", POS: PRON}},
+		"les":  {{Lemma: "le", POS: PRON}},
+		"su":   {{Lemma: "su", POS: DET}},
+		"sus":  {{Lemma: "su", POS: DET}},
+	},
+	"fr": {
+		"a":    {{Lemma: "avoir", POS: VERB}},
+		"à":    {{Lemma: "à", POS: ADP}},
+		"y":    {{Lemma: "y", POS: PRON}},
+		"ou":   {{Lemma: "ou", POS: CONJ}},
+		"où":   {{Lemma: "où", POS: PRON}},
+		"et":   {{Lemma: "et", POS: CONJ}},
+		"ni":   {{Lemma: "ni", POS: CONJ}},
+		"mais": {{Lemma: "mais", POS: CONJ}},
+		"que":  {{Lemma: "que", POS: CONJ}, {Lemma: "que", POS: PRON}},
+		"le":   {{Lemma: "le", POS: DET}, {Lemma: "le", POS: PRON}},
+		"la":   {{Lemma: "le", POS: DET}, {Lemma: "le", POS: PRON}},
+		"les":  {{Lemma: "le", POS: DET}, {Lemma: "les", POS: PRON}},
+		"l'":   {{Lemma: "le", POS: DET}, {Lemma: "le", POS: PRON}},
+		"un":   {{Lemma: "un", POS: DET}, {Lemma: "un", POS: PRON}},
+		"une":  {{Lemma: "un", POS: DET}, {Lemma: "un", POS: PRON}},
+		"de":   {{Lemma: "de", POS: ADP}, {Lemma: "de", POS: DET}},
+		"en":   {{Lemma: "en", POS: ADP}, {Lemma: "en", POS: PRON}},
+		"dans": {{Lemma: "dans", POS: ADP}},
+		"sur":  {{Lemma: "sur", POS: ADP}},
+		"pour": {{Lemma: "pour", POS: ADP}},
+		"par":  {{Lemma: "par", POS: ADP}},
+		"avec": {{Lemma: "avec", POS: ADP}},
+		"se":   {{Lemma: "se", POS: PRON}},
+		"ne":   {{Lemma: "ne", POS: ADV}},
+	},
+	"de": {
+		"der":   {{Lemma: "der", POS: DET}, {Lemma: "der", POS: PRON}},
+		"die":   {{Lemma: "der", POS: DET}, {Lemma: "der", POS: PRON}},
+		"das":   {{Lemma: "der", POS: DET}, {Lemma: "der", POS: PRON}},
+		"den":   {{Lemma: "der", POS: DET}, {Lemma: "der", POS: PRON}},
+		"dem":   {{Lemma: "der", POS: DET}, {Lemma: "der", POS: PRON}},
+		"des":   {{Lemma: "der", POS: DET}},
+		"ein":   {{Lemma: "ein", POS: DET}},
+		"eine":  {{Lemma: "ein", POS: DET}},
+		"einen": {{Lemma: "ein", POS: DET}},
+		"einem": {{Lemma: "ein", POS: DET}},
+		"einer": {{Lemma: "ein", POS: DET}},
+		"und":   {{Lemma: "und", POS: CONJ}},
+		"oder":  {{Lemma: "oder", POS: CONJ}},
+		"aber":  {{Lemma: "aber", POS: ADV}, {Lemma: "aber", POS: CONJ}},
+		"zu":    {{Lemma: "zu", POS: ADP}, {Lemma: "zu", POS: ADV}},
+		"in":    {{Lemma: "in", POS: ADP}},
+		"an":    {{Lemma: "an", POS: ADP}},
+		"auf":   {{Lemma: "auf", POS: ADP}},
+		"mit":   {{Lemma: "mit", POS: ADP}},
+		"von":   {{Lemma: "von", POS: ADP}},
+		"es":    {{Lemma: "es", POS: PRON}},
+		"ich":   {{Lemma: "ich", POS: PRON}},
+	},
+}
+
+// WithSingleCharacters sets the policy for tokens of one character ("y",
+// "a", ","), PolicyDefault otherwise
+func WithSingleCharacters(p WordPolicy) Option {
+	return func(l *Lemmatizer) {
+		l.singleCharacters = p
+	}
+}
+
+// WithFunctionWords sets the policy for the words of the closed-class
+// table of the language, PolicyDefault otherwise
+func WithFunctionWords(p WordPolicy) Option {
+	return func(l *Lemmatizer) {
+		l.functionWords = p
+	}
+}
+
+// policy returns the WordPolicy of form and, for function words, its
+// closed-class readings. The table is tried with form as is and
+// normalized, so "à" and "a" stay apart in French.
+func (l *Lemmatizer) policy(form string) (WordPolicy, []Analysis) {
+	table := closedClass[l.lang]
+	if l.functionWords != PolicyDefault && table != nil {
+		analyses, ok := table[form]
+		if !ok {
+			analyses, ok = table[l.normalizer.Normalize(form)]
+		}
+		if ok {
+			return l.functionWords, analyses
+		}
+	}
+	if l.singleCharacters != PolicyDefault && utf8.RuneCountInString(form) == 1 {
+		return l.singleCharacters, nil
+	}
+	return PolicyDefault, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+// Changelog lists the entries of a LanguageDictionary that differ from a
+// previous release
+type Changelog struct {
+	Language string        `json:"language"`
+	Name     string        `json:"name"`
+	Summary  ChangeSummary `json:"summary"`
+	Added    []EntryChange `json:"added"`
+	Removed  []EntryChange `json:"removed"`
+	Changed  []EntryChange `json:"changed"`
+}
+
+// ChangeSummary counts the changes per PoS
+type ChangeSummary struct {
+	Added   map[string]int `json:"added"`
+	Removed map[string]int `json:"removed"`
+	Changed map[string]int `json:"changed"`
+}
+
+// EntryChange is a form whose lemma was added, removed or changed.
+// Previous is the lemma in the previous release.
+type EntryChange struct {
+	POS      string `json:"pos"`
+	Form     string `json:"form"`
+	Lemma    string `json:"lemma,omitempty"`
+	Previous string `json:"previous,omitempty"`
+}
+
+// loadPrevious reads the JSON artifact of langDict from a previous
+// release, <dir>/<lang>/<name>.json
+func loadPrevious(dir string, langDict *LanguageDictionary) (Dicts, error) {
+	fileName := filepath.Join(dir, langDict.Language, strings.ToLower(langDict.Name)+".json")
+	content, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return Dicts{}, nil // new in this release
+	}
+	if err != nil {
+		return nil, err
+	}
+	var previous Dicts
+	if err := json.Unmarshal(textio.TrimBOM(content), &previous); err != nil {
+		return nil, fmt.Errorf("parse %v: %v", fileName, err)
+	}
+	return previous, nil
+}
+
+// diff compares langDict with the previous entries
+func diff(previous Dicts, langDict *LanguageDictionary) *Changelog {
+	c := &Changelog{
+		Language: langDict.Language,
+		Name:     langDict.Name,
+		Summary: ChangeSummary{
+			Added:   make(map[string]int),
+			Removed: make(map[string]int),
+			Changed: make(map[string]int),
+		},
+		Added:   []EntryChange{},
+		Removed: []EntryChange{},
+		Changed: []EntryChange{},
+	}
+	for pos, dict := range langDict.Entries {
+		old := previous[pos]
+		for form, lemma := range dict {
+			prev, ok := old[form]
+			switch {
+			case !ok:
+				c.Added = append(c.Added, EntryChange{POS: pos, Form: form, Lemma: lemma})
+				c.Summary.Added[pos]++
+			case prev != lemma:
+				c.Changed = append(c.Changed, EntryChange{POS: pos, Form: form, Lemma: lemma, Previous: prev})
+				c.Summary.Changed[pos]++
+			}
+		}
+	}
+	for pos, old := range previous {
+		dict := langDict.Entries[pos]
+		for form, prev := range old {
+			if _, ok := dict[form]; !ok {
+				c.Removed = append(c.Removed, EntryChange{POS: pos, Form: form, Previous: prev})
+				c.Summary.Removed[pos]++
+			}
+		}
+	}
+	for _, changes := range [][]EntryChange{c.Added, c.Removed, c.Changed} {
+		sort.Slice(changes, func(i, j int) bool {
+			if changes[i].POS != changes[j].POS {
+				return changes[i].POS < changes[j].POS
+			}
+			return changes[i].Form < changes[j].Form
+		})
+	}
+	return c
+}
+
+// writeChangelog writes c to <output>/<lang>/<name>.changelog.json
+func writeChangelog(m *Manifest, c *Changelog) error {
+	outFile := filepath.Join(m.Output, c.Language, strings.ToLower(c.Name)+".changelog.json")
+	content, err := json.MarshalIndent(c, "", "\t")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outFile, append(content, '\n'), 0644)
+}
+
+// printChangelog reports the number of changes
+func printChangelog(c *Changelog) {
+	fmt.Printf("%v changes: %v added, %v removed, %v changed\n", c.Name, len(c.Added), len(c.Removed), len(c.Changed))
+}
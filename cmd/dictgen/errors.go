@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+// ErrInvalidEntry is a line that is not a form, a lemma and a tag
+type ErrInvalidEntry struct {
+	File  string
+	Line  int
+	Entry string
+}
+
+func (e *ErrInvalidEntry) Error() string {
+	return fmt.Sprintf("%v:%v: invalid entry %q, expected form, lemma and tag", e.File, e.Line, e.Entry)
+}
+
+// ErrUnmappedPOS is an entry whose tag has no PoS in the mapping, an
+// error with StrictPOS
+type ErrUnmappedPOS struct {
+	File  string
+	Line  int
+	Entry string
+	Tag   string
+}
+
+func (e *ErrUnmappedPOS) Error() string {
+	return fmt.Sprintf("%v:%v: unmapped tag %q in %q", e.File, e.Line, e.Tag, e.Entry)
+}
+
+// ErrTooManyErrors stops the generation once the entries have more
+// errors than the budget, see Manifest.MaxErrors
+type ErrTooManyErrors struct {
+	Budget int
+	// Last is the error over the budget
+	Last error
+}
+
+func (e *ErrTooManyErrors) Error() string {
+	return fmt.Sprintf("%v (more than %v entry errors, see -max-errors)", e.Last, e.Budget)
+}
+
+// Report collects the errors of the entries skipped in a run, up to
+// MaxErrors (none with 0, any number with a negative budget)
+type Report struct {
+	MaxErrors int
+	Errors    []error
+}
+
+// add records err, returning an ErrTooManyErrors once the budget is
+// spent, after which the generation stops
+func (r *Report) add(err error) error {
+	if r.MaxErrors >= 0 && len(r.Errors) >= r.MaxErrors {
+		return &ErrTooManyErrors{Budget: r.MaxErrors, Last: err}
+	}
+	r.Errors = append(r.Errors, err)
+	return nil
+}
+
+// maxPrinted is the number of errors print lists
+const maxPrinted = 20
+
+// print lists the errors of r, the first maxPrinted of them
+func (r *Report) print(w io.Writer) {
+	for i, err := range r.Errors {
+		if i == maxPrinted {
+			fmt.Fprintf(w, "... and %v more\n", len(r.Errors)-maxPrinted)
+			break
+		}
+		fmt.Fprintln(w, err)
+	}
+}
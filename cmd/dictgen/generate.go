@@ -0,0 +1,232 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"github.com/lang-ai/simple_lemmatizer/internal/compressio"
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+// Dicts is a dictionary of posCodes-Dict
+type Dicts map[string]Dict
+
+// Dict is a dictionary of form-lemma relations
+type Dict map[string]string
+
+type LanguageDictionary struct {
+	Language string
+	// Name of the generated variable: Dictionary, Multiwords, Paradigms,
+	// Inflections or Tags
+	Name string
+	// Key and Value name what Entries map, per PoS: Form to Lemma, Lemma
+	// to Paradigm, Lemma to Forms or Form to Tags
+	Key, Value string
+	// Register is the lemmatizer function the Go index calls from init to
+	// register the entries, empty when the language package does
+	Register string
+	Entries  Dicts
+	// Counts is the number of entries read per PoS, skipped ones under ""
+	Counts map[string]int
+	// Skipped counts the entries skipped per unmapped tag
+	Skipped map[string]int
+	// Issues are the problems found by the linter
+	Issues []Issue
+}
+
+// parser turns the entries of one language into Dicts
+type parser struct {
+	delimiter  string
+	pos        map[string]string
+	maxPrefix  int // longest tag prefix of pos, in runes
+	strict     bool
+	normalizer lemmatizer.Normalizer
+	// paradigms is nil for languages without ParadigmRules
+	paradigms *paradigms
+	// inflections is nil for languages without Inflections
+	inflections *inflections
+	// tags is nil for languages without Tags
+	tags *tags
+	lint *linter
+	// report collects the errors of the entries, skipped up to its
+	// budget
+	report *Report
+}
+
+func newParser(delimiter string, pos map[string]string, strict bool, normalizer lemmatizer.Normalizer, report *Report) *parser {
+	p := &parser{delimiter: delimiter, pos: pos, strict: strict, normalizer: normalizer, report: report}
+	for tag := range pos {
+		if n := len([]rune(tag)); n > p.maxPrefix {
+			p.maxPrefix = n
+		}
+	}
+	return p
+}
+
+// posOf returns the PoS of the longest prefix of tag in the mapping
+func (p *parser) posOf(tag string) (string, bool) {
+	runes := []rune(tag)
+	n := len(runes)
+	if n > p.maxPrefix {
+		n = p.maxPrefix
+	}
+	for ; n > 0; n-- {
+		if pos, ok := p.pos[string(runes[:n])]; ok {
+			return pos, true
+		}
+	}
+	return "", false
+}
+
+// processEntry adds an entry to langDict, returning an ErrInvalidEntry
+// or ErrUnmappedPOS for entries that must be skipped
+func (p *parser) processEntry(langDict *LanguageDictionary, file string, line int, entry string) error {
+	sEntry := strings.Split(entry, p.delimiter) // form lemma pos
+	if len(sEntry) != 3 || sEntry[2] == "" {
+		return &ErrInvalidEntry{File: file, Line: line, Entry: entry}
+	}
+	if p.lint.entry(file, line, entry, sEntry[0], sEntry[1]) {
+		return nil // reported, skip it
+	}
+	dictKey, ok := p.posOf(sEntry[2])
+	if !ok {
+		if p.strict {
+			return &ErrUnmappedPOS{File: file, Line: line, Entry: entry, Tag: sEntry[2]}
+		}
+		langDict.Counts[""]++
+		langDict.Skipped[sEntry[2]]++
+		return nil // Skip it
+	}
+	langDict.Counts[dictKey]++
+	p.lint.tag(file, line, entry, langDict.Name+" "+dictKey, sEntry[0], sEntry[1], sEntry[2])
+	if langDict.Name == "Dictionary" {
+		p.paradigms.add(dictKey, sEntry[1], sEntry[2])
+		p.inflections.add(dictKey, sEntry[0], sEntry[1], sEntry[2])
+	}
+	dict, ok := langDict.Entries[dictKey]
+	if !ok {
+		dict = make(Dict)
+	}
+
+	if _, ok := dict[sEntry[0]]; !ok { // dont override, use first match
+		dict[sEntry[0]] = sEntry[1]
+		// Store the entry under its normalized form too, the Lemmatizer
+		// normalizes the same way before retrying a lookup
+		modified := p.normalizer.Normalize(sEntry[0])
+		if modified != sEntry[0] { // instance modified. Try to add the normalized one
+			if _, ok := dict[modified]; !ok { // dont override, use first match
+				dict[modified] = sEntry[1]
+			}
+		}
+	}
+	if langDict.Name == "Dictionary" {
+		p.tags.add(dict, dictKey, sEntry[0], sEntry[1], sEntry[2], p.normalizer.Normalize)
+	}
+	langDict.Entries[dictKey] = dict
+	return nil
+}
+
+func (p *parser) loadDict(langDict *LanguageDictionary, dictFileName string) error {
+	content, err := compressio.ReadFile(dictFileName)
+	if err != nil {
+		return err
+	}
+	entries := textio.Lines(content)
+	for i, entry := range entries {
+		if entry != "" {
+			if err := p.processEntry(langDict, dictFileName, i+1, entry); err != nil {
+				// skip it, unless the budget is spent
+				if err := p.report.add(err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// loadLangDicts parses every file of lang: the Dictionary, the Multiwords
+// when the language has multiword expressions, the Paradigms when it has
+// ParadigmRules and the Inflections and Tags when enabled. The entries
+// with errors are skipped and added to report.
+func loadLangDicts(m *Manifest, lang Language, report *Report) ([]*LanguageDictionary, error) {
+	p := newParser(m.Delimiter, m.posFor(lang), m.StrictPOS, m.normalizerFor(lang), report)
+	p.lint = newLinter(lang.Code)
+	p.paradigms = newParadigms(lang.Paradigms)
+	if lang.Inflections {
+		p.inflections = newInflections()
+	}
+	if lang.Tags {
+		p.tags = newTags()
+	}
+	dictionary, err := p.loadLangDict(lang.Code, "Dictionary", lang.Files)
+	if err != nil {
+		return nil, err
+	}
+	p.lint.dictionary(dictionary.Entries, p.normalizer.Normalize)
+	dictionary.Issues, p.lint.issues = p.lint.issues, nil
+	langDicts := []*LanguageDictionary{dictionary}
+	if p.paradigms != nil {
+		langDicts = append(langDicts, p.paradigms.build(dictionary, p.normalizer.Normalize))
+	}
+	if p.inflections != nil {
+		langDicts = append(langDicts, p.inflections.build(lang.Code))
+	}
+	if p.tags != nil {
+		langDicts = append(langDicts, p.tags.build(lang.Code))
+	}
+	if len(lang.Multiwords) > 0 {
+		multiwords, err := p.loadLangDict(lang.Code, "Multiwords", lang.Multiwords)
+		if err != nil {
+			return nil, err
+		}
+		multiwords.Issues, p.lint.issues = p.lint.issues, nil
+		langDicts = append(langDicts, multiwords)
+	}
+	return langDicts, nil
+}
+
+func (p *parser) loadLangDict(code, name string, files []string) (*LanguageDictionary, error) {
+	langDict := &LanguageDictionary{
+		Language: code,
+		Name:     name,
+		Key:      "Form",
+		Value:    "Lemma",
+		Entries:  make(Dicts),
+		Counts:   make(map[string]int),
+		Skipped:  make(map[string]int),
+	}
+	for _, d := range files {
+		err := p.loadDict(langDict, d)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return langDict, nil
+}
+
+// generateLangDict writes (or checks) every format of langDict in
+// <output>/<lang>/, see render, and returns the number of files written
+// (or differing)
+func generateLangDict(m *Manifest, langDict *LanguageDictionary, check bool) (int, error) {
+	written := 0
+	for _, format := range m.Formats {
+		if format == "lemdict" && langDict.Value != "Lemma" {
+			continue // lemdict files only hold forms and lemmas
+		}
+		if format == "spacy" && langDict.Name != "Dictionary" {
+			continue // the spaCy table is the lemmas of single words
+		}
+		r := renderers[format]
+		if format == "lemdict" {
+			r = lemdictRenderer(m.Compression)
+		}
+		n, err := render(filepath.Join(m.Output, langDict.Language), format, r, *langDict, check)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
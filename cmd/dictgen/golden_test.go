@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of fixtures/dictgen")
+
+// goldenManifest is the manifest of the lexicon exercising the generator,
+// whose output of every format is in fixtures/dictgen/testdata
+const goldenManifest = "fixtures/dictgen/dictgen.json"
+
+// TestGolden renders the fixture lexicon in every format and compares
+// the files with the golden ones; -update rewrites them after a
+// deliberate change:
+//
+//	go test ./cmd/dictgen -run Golden -update
+func TestGolden(t *testing.T) {
+	root := moduleRoot()
+	m, err := buildManifest(root, goldenManifest, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.setDefaults()
+	if err := m.validate(); err != nil {
+		t.Fatal(err)
+	}
+	golden := m.Output
+	if !*update {
+		m.Output = t.TempDir()
+	}
+	report := &Report{MaxErrors: m.MaxErrors}
+	for _, lang := range m.Languages {
+		langDicts, err := loadLangDicts(m, lang, report)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, langDict := range langDicts {
+			if _, err := generateLangDict(m, langDict, false); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if len(report.Errors) > 0 {
+		t.Fatalf("%v entries skipped for errors, the first: %v", len(report.Errors), report.Errors[0])
+	}
+	if *update {
+		return
+	}
+
+	got, want := treeFiles(t, m.Output), treeFiles(t, golden)
+	for name := range want {
+		if _, ok := got[name]; !ok {
+			t.Errorf("%v is no longer generated", name)
+		}
+	}
+	for _, name := range sortedNames(got) {
+		wantContent, ok := want[name]
+		if !ok {
+			t.Errorf("%v is generated but has no golden file", name)
+			continue
+		}
+		if line, differs := firstDifference(got[name], wantContent); differs {
+			t.Errorf("%v differs from the golden file at line %v", name, line)
+		}
+	}
+}
+
+// treeFiles returns the content of the files under dir, by path relative
+// to it
+func treeFiles(t *testing.T, dir string) map[string][]byte {
+	files := make(map[string][]byte)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(name)] = content
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func sortedNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// firstDifference returns the first line, from 1, where a and b differ
+func firstDifference(a, b []byte) (int, bool) {
+	if bytes.Equal(a, b) {
+		return 0, false
+	}
+	aLines, bLines := strings.Split(string(a), "\n"), strings.Split(string(b), "\n")
+	for i := 0; i < len(aLines) && i < len(bLines); i++ {
+		if aLines[i] != bLines[i] {
+			return i + 1, true
+		}
+	}
+	if len(aLines) < len(bLines) {
+		return len(aLines) + 1, true
+	}
+	return len(bLines) + 1, true
+}
@@ -0,0 +1,56 @@
+package main
+
+import "strings"
+
+// inflections accumulates the forms and tags of each lemma while parsing,
+// in the order of the input files
+type inflections struct {
+	forms map[string]map[string][]string // PoS, lemma, form and tag pairs
+	seen  map[string]bool
+}
+
+func newInflections() *inflections {
+	return &inflections{forms: make(map[string]map[string][]string), seen: make(map[string]bool)}
+}
+
+// add records an entry, once
+func (in *inflections) add(pos, form, lemma, tag string) {
+	if in == nil {
+		return
+	}
+	key := pos + " " + form + " " + lemma + " " + tag
+	if in.seen[key] {
+		return
+	}
+	in.seen[key] = true
+	lemmas, ok := in.forms[pos]
+	if !ok {
+		lemmas = make(map[string][]string)
+		in.forms[pos] = lemmas
+	}
+	lemmas[lemma] = append(lemmas[lemma], form, tag)
+}
+
+// build returns the Inflections LanguageDictionary: the value of a lemma
+// is its forms and their tags, separated by spaces ("canto VMIP1S0 cantó
+// VMIS3S0...")
+func (in *inflections) build(lang string) *LanguageDictionary {
+	langDict := &LanguageDictionary{
+		Language: lang,
+		Name:     "Inflections",
+		Key:      "Lemma",
+		Value:    "Forms",
+		Entries:  make(Dicts),
+		Counts:   make(map[string]int),
+		Skipped:  make(map[string]int),
+	}
+	for pos, lemmas := range in.forms {
+		dict := make(Dict, len(lemmas))
+		for lemma, forms := range lemmas {
+			dict[lemma] = strings.Join(forms, " ")
+		}
+		langDict.Entries[pos] = dict
+		langDict.Counts[pos] = len(dict)
+	}
+	return langDict
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Issue is a problem found in the entries of a language
+type Issue struct {
+	Language string
+	// File and Line locate the entry, empty for the issues found across
+	// entries (lemma-not-form, cycle)
+	File string
+	Line int
+	// Check is the name of the check: control, mixed-script (the entry is
+	// skipped), conflict, lemma-not-form or cycle
+	Check   string
+	Entry   string
+	Message string
+}
+
+// scripts are those mixed-script tells apart, the letters of others count
+// as one "other" script
+var scripts = []struct {
+	name  string
+	table *unicode.RangeTable
+}{
+	{"Latin", unicode.Latin}, {"Greek", unicode.Greek}, {"Cyrillic", unicode.Cyrillic},
+	{"Armenian", unicode.Armenian}, {"Hebrew", unicode.Hebrew}, {"Arabic", unicode.Arabic},
+	{"Han", unicode.Han}, {"Hiragana", unicode.Hiragana}, {"Katakana", unicode.Katakana}, {"Hangul", unicode.Hangul},
+}
+
+// linter collects the issues of one language
+type linter struct {
+	language string
+	issues   []Issue
+	// lemmas by PoS, form and tag, to find conflicting duplicates
+	tagged map[string]string
+}
+
+func newLinter(language string) *linter {
+	return &linter{language: language, tagged: make(map[string]string)}
+}
+
+func (l *linter) report(file string, line int, check, entry, format string, args ...interface{}) {
+	l.issues = append(l.issues, Issue{Language: l.language, File: file, Line: line, Check: check, Entry: entry, Message: fmt.Sprintf(format, args...)})
+}
+
+// entry checks the form and lemma of an entry, reporting whether it must
+// be skipped
+func (l *linter) entry(file string, line int, entry, form, lemma string) bool {
+	for _, s := range []string{form, lemma} {
+		if i := strings.IndexFunc(s, unicode.IsControl); i >= 0 {
+			l.report(file, line, "control", entry, "control character %U in %q", []rune(s[i:])[0], s)
+			return true
+		}
+		if names := scriptsOf(s); len(names) > 1 {
+			l.report(file, line, "mixed-script", entry, "%q mixes %v", s, strings.Join(names, " and "))
+			return true
+		}
+	}
+	return false
+}
+
+// tag checks that no previous entry maps form with the same tag to
+// another lemma
+func (l *linter) tag(file string, line int, entry, pos, form, lemma, tag string) {
+	key := pos + "\x00" + form + "\x00" + tag
+	previous, ok := l.tagged[key]
+	if !ok {
+		l.tagged[key] = lemma
+		return
+	}
+	if previous != lemma {
+		l.report(file, line, "conflict", entry, "%v %v is already %v, keeping it", form, tag, previous)
+	}
+}
+
+// dictionary checks the parsed Dictionary: lemmas should be forms of
+// their PoS too, and following lemmas from form to form must not loop
+func (l *linter) dictionary(entries Dicts, normalize func(string) string) {
+	posList := make([]string, 0, len(entries))
+	for pos := range entries {
+		posList = append(posList, pos)
+	}
+	sort.Strings(posList)
+	for _, pos := range posList {
+		dict := entries[pos]
+		var lemmas []string
+		seen := make(map[string]bool)
+		for _, lemma := range dict {
+			if !seen[lemma] {
+				seen[lemma] = true
+				lemmas = append(lemmas, lemma)
+			}
+		}
+		sort.Strings(lemmas)
+		for _, lemma := range lemmas {
+			if _, ok := dict[lemma]; !ok {
+				if _, ok := dict[normalize(lemma)]; !ok {
+					l.report("", 0, "lemma-not-form", lemma, "lemma %v is not a %v form", lemma, pos)
+				}
+			}
+		}
+		forms := make([]string, 0, len(dict))
+		for form := range dict {
+			forms = append(forms, form)
+		}
+		sort.Strings(forms)
+		for _, form := range forms {
+			if cycle := cycleFrom(dict, form); cycle != nil {
+				l.report("", 0, "cycle", form, "%v lemmas loop: %v", pos, strings.Join(cycle, " -> "))
+			}
+		}
+	}
+}
+
+// cycleFrom returns the loop of lemmas starting at form, if form is the
+// smallest form of a loop (so each loop is reported once)
+func cycleFrom(dict Dict, form string) []string {
+	chain := []string{form}
+	visited := map[string]bool{form: true}
+	for current := form; ; {
+		next, ok := dict[current]
+		if !ok || next == current {
+			return nil
+		}
+		if next == form {
+			return append(chain, form)
+		}
+		if visited[next] || next < form {
+			return nil
+		}
+		visited[next] = true
+		chain = append(chain, next)
+		current = next
+	}
+}
+
+// scriptsOf returns the scripts of the letters of s
+func scriptsOf(s string) []string {
+	var names []string
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		name := "other"
+		for _, script := range scripts {
+			if unicode.Is(script.table, r) {
+				name = script.name
+				break
+			}
+		}
+		if !contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// printLint summarizes the issues of langDict per check
+func printLint(langDict *LanguageDictionary) {
+	if len(langDict.Issues) == 0 {
+		return
+	}
+	counts := make(map[string]int)
+	for _, issue := range langDict.Issues {
+		counts[issue.Check]++
+	}
+	checks := make([]string, 0, len(counts))
+	for check, n := range counts {
+		checks = append(checks, fmt.Sprintf("%v (%v)", check, n))
+	}
+	sort.Strings(checks)
+	fmt.Printf("[Lemmatizer] %v %v: %v lint issues: %v\n", langDict.Language, langDict.Name, len(langDict.Issues), strings.Join(checks, ", "))
+}
+
+// writeLintReport writes the issues as tab separated lines: language,
+// location, check, entry and message
+func writeLintReport(fileName string, issues []Issue) error {
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "language\tlocation\tcheck\tentry\tmessage\n")
+	for _, issue := range issues {
+		location := ""
+		if issue.File != "" {
+			location = fmt.Sprintf("%v:%v", issue.File, issue.Line)
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", issue.Language, location, issue.Check, issue.Entry, issue.Message)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
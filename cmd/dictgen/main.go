@@ -0,0 +1,290 @@
+// Command dictgen loads morphological dictionaries (form lemma tag lines)
+// and generates the <lang>/dictionary.go packages (sharded per PoS in
+// <lang>/dictionary_<pos>.go), and optionally <lang>/dictionary.json and
+// <lang>/dictionary.ts. Entries are sorted and unchanged files are not
+// rewritten, so updates only touch the PoS that changed.
+//
+// What to generate is described by a JSON manifest (see dictgen.json in
+// the module root); flags override it:
+//
+//	dictgen -manifest dictgen.json
+//	dictgen -manifest dictgen.json -lang es -dry-run
+//	dictgen -previous release-1.2/ -formats go,json
+//	dictgen -formats lemdict -compression zstd -out dist
+//	dictgen -manifest fixtures/dictgen/dictgen.json -check
+//	dictgen -lint-report lint.tsv -strict
+//	dictgen -max-errors 100
+//	dictgen -lang es -tags
+//	dictgen -manifest "" -lang pt -files data/pt/a.adj,data/pt/a.nom -out /tmp
+//
+// Relative paths, those of the flags included, are relative to the module
+// root (the closest directory with a go.mod), so the //go:generate
+// directives of the language packages run it as from the root:
+//
+//	//go:generate go run ../cmd/dictgen -lang es
+//
+// dictgen stats reports the entries, lemmas, ambiguous forms and memory
+// of dictionaries per PoS, see runStats. dictgen suggest turns the miss
+// logs of a deployment into candidate entries, see runSuggest.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "stats" {
+		runStats(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "suggest" {
+		runSuggest(os.Args[2:])
+		return
+	}
+	manifestFlag := flag.String("manifest", "dictgen.json", "JSON manifest, empty to configure with flags only")
+	langFlag := flag.String("lang", "", "only generate this language, or the language of -files")
+	filesFlag := flag.String("files", "", "comma separated input files, replaces the manifest languages (needs -lang)")
+	outFlag := flag.String("out", "", "output directory, overrides the manifest")
+	delimiterFlag := flag.String("delimiter", "", "entry delimiter, overrides the manifest")
+	formatsFlag := flag.String("formats", "", "comma separated list of artifacts to generate: "+formatNames())
+	compressionFlag := flag.String("compression", "", "compress the lemdict artifacts: gzip or zstd, overrides the manifest")
+	check := flag.Bool("check", false, "compare the generated files with those in the output directory instead of writing them, exit status 1 on differences")
+	dryRun := flag.Bool("dry-run", false, "parse and report entry counts per PoS without writing anything")
+	posFileFlag := flag.String("pos-file", "", "JSON table of tag prefixes to PoS, overrides the manifest mapping")
+	strictPOS := flag.Bool("strict-pos", false, "fail on tags without a PoS mapping instead of skipping them")
+	maxErrors := flag.Int("max-errors", 0, "skip up to this many invalid entries before stopping, -1 for any number, overrides the manifest")
+	strict := flag.Bool("strict", false, "fail when the linter finds any issue in the entries")
+	lintReport := flag.String("lint-report", "", "write the issues found by the linter to this tab separated file")
+	tagsFlag := flag.Bool("tags", false, "also generate the <lang>/tags.go full tags of the forms, for Analysis.Tags (increases the size of the packages)")
+	previousFlag := flag.String("previous", "", "directory with the JSON artifacts of a previous release, to write <lang>/<name>.changelog.json")
+	flag.Parse()
+
+	root := moduleRoot()
+	m, err := buildManifest(root, *manifestFlag, *langFlag, *filesFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *outFlag != "" {
+		m.Output = rootPath(root, *outFlag)
+	}
+	if *delimiterFlag != "" {
+		m.Delimiter = *delimiterFlag
+	}
+	if *formatsFlag != "" {
+		m.Formats = splitList(*formatsFlag)
+	}
+	if *compressionFlag != "" {
+		m.Compression = *compressionFlag
+	}
+	if *posFileFlag != "" {
+		if m.POS, err = loadPOS(root, *posFileFlag, nil); err != nil {
+			log.Fatal(err)
+		}
+		for i := range m.Languages {
+			m.Languages[i].POS = nil
+		}
+	}
+	if *strictPOS {
+		m.StrictPOS = true
+	}
+	if *strict {
+		m.Strict = true
+	}
+	if *maxErrors != 0 {
+		m.MaxErrors = *maxErrors
+	}
+	if *lintReport != "" {
+		m.LintReport = rootPath(root, *lintReport)
+	}
+	if *tagsFlag {
+		for i := range m.Languages {
+			m.Languages[i].Tags = true
+		}
+	}
+	if m.Output == "" {
+		m.Output = root
+	}
+	m.setDefaults()
+	if err := m.validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("Starting dictionaries generation...")
+	differing := 0
+	var issues []Issue
+	report := &Report{MaxErrors: m.MaxErrors}
+	for _, lang := range m.Languages {
+		written := 0
+		fmt.Printf("[Lemmatizer] Loading %v dictionaries...\n", lang.Code)
+		langDicts, err := loadLangDicts(m, lang, report)
+		if err != nil {
+			report.print(os.Stderr)
+			log.Fatal(err)
+		}
+		for _, langDict := range langDicts {
+			printSkipped(langDict)
+			printLint(langDict)
+			issues = append(issues, langDict.Issues...)
+			var changelog *Changelog
+			if *previousFlag != "" {
+				previous, err := loadPrevious(rootPath(root, *previousFlag), langDict)
+				if err != nil {
+					log.Fatal(err)
+				}
+				changelog = diff(previous, langDict)
+			}
+			if *dryRun {
+				printCounts(langDict)
+				if changelog != nil {
+					printChangelog(changelog)
+				}
+				continue
+			}
+			n, err := generateLangDict(m, langDict, *check)
+			if err != nil {
+				log.Fatal(err)
+			}
+			written += n
+			if changelog != nil && !*check {
+				if err := writeChangelog(m, changelog); err != nil {
+					log.Fatal(err)
+				}
+			}
+		}
+		differing += written
+		if *dryRun || *check {
+			continue
+		}
+		fmt.Printf("[Lemmatizer] %v Dictionaries loaded, %v files written.\n", lang.Code, written)
+	}
+	if m.LintReport != "" {
+		if err := writeLintReport(m.LintReport, issues); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if m.Strict && len(issues) > 0 {
+		log.Fatalf("%v lint issues, failing with -strict", len(issues))
+	}
+	if len(report.Errors) > 0 {
+		report.print(os.Stderr)
+		fmt.Fprintf(os.Stderr, "[Lemmatizer] %v entries skipped for errors, the files generated lack them\n", len(report.Errors))
+		os.Exit(1)
+	}
+	if *check && differing > 0 {
+		os.Exit(1)
+	}
+}
+
+// buildManifest loads the manifest and applies the language selection
+// flags, their paths relative to root
+func buildManifest(root, manifestFile, lang, files string) (*Manifest, error) {
+	m := &Manifest{}
+	if manifestFile != "" {
+		var err error
+		if m, err = loadManifest(rootPath(root, manifestFile)); err != nil {
+			return nil, err
+		}
+	}
+	if files != "" {
+		if lang == "" {
+			return nil, fmt.Errorf("-files needs -lang")
+		}
+		m.Languages = []Language{{Code: lang, Files: splitList(files)}}
+		resolvePaths(root, m.Languages[0].Files)
+		return m, nil
+	}
+	if lang != "" {
+		for _, l := range m.Languages {
+			if l.Code == lang {
+				m.Languages = []Language{l}
+				return m, nil
+			}
+		}
+		return nil, fmt.Errorf("language %v not in manifest %v", lang, manifestFile)
+	}
+	return m, nil
+}
+
+// moduleRoot returns the closest directory with a go.mod from the working
+// directory up, the working directory itself without any
+func moduleRoot() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "."
+	}
+	for dir := wd; ; {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return wd
+		}
+		dir = parent
+	}
+}
+
+// rootPath returns path relative to root, as is when absolute or empty
+func rootPath(root, path string) string {
+	if path == "" {
+		return path
+	}
+	if path = textio.Path(path); filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(root, path)
+}
+
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// printCounts reports the entries read and the forms stored per PoS
+func printCounts(langDict *LanguageDictionary) {
+	var posList []string
+	for pos := range langDict.Counts {
+		if pos != "" {
+			posList = append(posList, pos)
+		}
+	}
+	sort.Strings(posList)
+	fmt.Printf("%v\n%-8v %10v %10v\n", langDict.Name, "PoS", "entries", "forms")
+	total := 0
+	for _, pos := range posList {
+		fmt.Printf("%-8v %10v %10v\n", pos, langDict.Counts[pos], len(langDict.Entries[pos]))
+		total += langDict.Counts[pos]
+	}
+	fmt.Printf("%-8v %10v\n", "skipped", langDict.Counts[""])
+	fmt.Printf("%-8v %10v\n", "total", total+langDict.Counts[""])
+}
+
+// printSkipped warns about the entries skipped for their unmapped tags
+func printSkipped(langDict *LanguageDictionary) {
+	if len(langDict.Skipped) == 0 {
+		return
+	}
+	tags := make([]string, 0, len(langDict.Skipped))
+	for tag := range langDict.Skipped {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for i, tag := range tags {
+		tags[i] = fmt.Sprintf("%v (%v)", tag, langDict.Skipped[tag])
+	}
+	fmt.Printf("[Lemmatizer] %v %v: skipped %v entries with unmapped tags: %v\n",
+		langDict.Language, langDict.Name, langDict.Counts[""], strings.Join(tags, ", "))
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"github.com/lang-ai/simple_lemmatizer/internal/compressio"
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+// Manifest describes what dictgen generates
+type Manifest struct {
+	// Output is the directory where the <lang>/ packages are written
+	Output string `json:"output"`
+	// Delimiter separates form, lemma and tag in an entry
+	Delimiter string `json:"delimiter"`
+	// POSFile is a JSON table mapping tag prefixes to the PoS the entry is
+	// stored under, see POS
+	POSFile string `json:"pos_file,omitempty"`
+	// POS maps tag prefixes (EAGLES codes such as "N", "NP" or "Fs") to
+	// PoS, the longest matching prefix wins. Its entries extend or
+	// override those of POSFile. Tags without a mapping are skipped.
+	POS map[string]string `json:"pos"`
+	// StrictPOS fails on tags without a mapping instead of skipping them
+	StrictPOS bool `json:"strict_pos,omitempty"`
+	// MaxErrors is the number of invalid entries (and unmapped tags with
+	// StrictPOS) skipped before the generation stops: 0 stops at the
+	// first, a negative budget never does. The generation then writes
+	// its files without them and exits with status 1, see Report.
+	MaxErrors int `json:"max_errors,omitempty"`
+	// Strict fails when the linter finds any issue, see Issue
+	Strict bool `json:"strict,omitempty"`
+	// LintReport is the file the issues of the linter are written to
+	LintReport string `json:"lint_report,omitempty"`
+	// Formats are the artifacts to generate: go, json, lemdict, spacy, ts
+	Formats []string `json:"formats"`
+	// Compression compresses the lemdict artifacts with gzip or zstd,
+	// which lemmatizer.LoadLemdict reads as they are
+	Compression string     `json:"compression,omitempty"`
+	Languages   []Language `json:"languages"`
+}
+
+// Language is one language dictionary of the manifest
+type Language struct {
+	Code  string   `json:"code"`
+	Files []string `json:"files"`
+	// Multiwords are files of multiword expressions, with the tokens of
+	// form and lemma joined by "_"
+	Multiwords []string `json:"multiwords,omitempty"`
+	// POSFile and POS override the manifest mapping for this language
+	POSFile string            `json:"pos_file,omitempty"`
+	POS     map[string]string `json:"pos,omitempty"`
+	// Paradigms enables the <lang>/paradigms.go map of lemmas to their
+	// inflection paradigm
+	Paradigms *ParadigmRules `json:"paradigms,omitempty"`
+	// Inflections enables the <lang>/inflections.go map of lemmas to their
+	// forms and tags, for lemmatizer.Inflect
+	Inflections bool `json:"inflections,omitempty"`
+	// Tags enables the <lang>/tags.go map of forms to their full tags
+	// (the EAGLES tags of FreeLing, VMIP3S0), for Analysis.Tags. It
+	// registers itself, see lemmatizer.RegisterTags.
+	Tags bool `json:"tags,omitempty"`
+	// Normalizer replaces lemmatizer.NormalizerFor(Code) to index the
+	// entries. The language package must register the same one, see
+	// lemmatizer.RegisterNormalizer.
+	Normalizer *lemmatizer.Normalizer `json:"normalizer,omitempty"`
+}
+
+// defaultPOS is the mapping of EAGLES tag initials to PoS when there is no
+// table, data/eagles.json has the complete one
+var defaultPOS = map[string]string{
+	"D": "DET",  // determiner
+	"A": "ADJ",  // adjective
+	"N": "NOUN", // noun
+	"V": "VERB", // verb
+	"R": "ADV",  // adverb
+	"S": "ADP",  // adposition
+	"C": "CONJ", // conjuntion
+	"P": "PRON", // pronoun
+	"I": "INTJ", // interjection
+}
+
+// loadManifest reads a JSON manifest. Relative file paths are resolved
+// against the directory of the manifest
+func loadManifest(fileName string) (*Manifest, error) {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(textio.TrimBOM(content), &m); err != nil {
+		return nil, fmt.Errorf("parse %v: %v", fileName, err)
+	}
+	dir := filepath.Dir(fileName)
+	if m.Output != "" {
+		if m.Output = textio.Path(m.Output); !filepath.IsAbs(m.Output) {
+			m.Output = filepath.Join(dir, m.Output)
+		}
+	}
+	if m.LintReport != "" {
+		if m.LintReport = textio.Path(m.LintReport); !filepath.IsAbs(m.LintReport) {
+			m.LintReport = filepath.Join(dir, m.LintReport)
+		}
+	}
+	if m.POS, err = loadPOS(dir, m.POSFile, m.POS); err != nil {
+		return nil, fmt.Errorf("%v: %v", fileName, err)
+	}
+	for i := range m.Languages {
+		lang := &m.Languages[i]
+		resolvePaths(dir, lang.Files)
+		resolvePaths(dir, lang.Multiwords)
+		if lang.POS, err = loadPOS(dir, lang.POSFile, lang.POS); err != nil {
+			return nil, fmt.Errorf("%v: language %v: %v", fileName, lang.Code, err)
+		}
+	}
+	return &m, nil
+}
+
+// loadPOS returns the mapping of the JSON table posFile, relative to dir,
+// extended with pos
+func loadPOS(dir, posFile string, pos map[string]string) (map[string]string, error) {
+	if posFile == "" {
+		return pos, nil
+	}
+	if posFile = textio.Path(posFile); !filepath.IsAbs(posFile) {
+		posFile = filepath.Join(dir, posFile)
+	}
+	content, err := ioutil.ReadFile(posFile)
+	if err != nil {
+		return nil, err
+	}
+	table := make(map[string]string)
+	if err := json.Unmarshal(textio.TrimBOM(content), &table); err != nil {
+		return nil, fmt.Errorf("parse %v: %v", posFile, err)
+	}
+	for tag, p := range pos {
+		table[tag] = p
+	}
+	return table, nil
+}
+
+// resolvePaths makes files relative to dir, with the separators of the
+// platform
+func resolvePaths(dir string, files []string) {
+	for i, f := range files {
+		if f = textio.Path(f); filepath.IsAbs(f) {
+			files[i] = f
+		} else {
+			files[i] = filepath.Join(dir, f)
+		}
+	}
+}
+
+// setDefaults fills the fields left empty
+func (m *Manifest) setDefaults() {
+	if m.Output == "" {
+		m.Output = "."
+	}
+	if m.Delimiter == "" {
+		m.Delimiter = " "
+	}
+	if len(m.POS) == 0 {
+		m.POS = defaultPOS
+	}
+	if len(m.Formats) == 0 {
+		m.Formats = []string{"go"}
+	}
+}
+
+// normalizerFor returns the Normalizer the entries of lang are indexed
+// with
+func (m *Manifest) normalizerFor(lang Language) lemmatizer.Normalizer {
+	if lang.Normalizer != nil {
+		return *lang.Normalizer
+	}
+	return lemmatizer.NormalizerFor(lang.Code)
+}
+
+// posFor returns the PoS mapping used for lang
+func (m *Manifest) posFor(lang Language) map[string]string {
+	if len(lang.POS) > 0 {
+		return lang.POS
+	}
+	return m.POS
+}
+
+// validate checks the manifest before anything is generated
+func (m *Manifest) validate() error {
+	if len(m.Languages) == 0 {
+		return fmt.Errorf("no languages to generate")
+	}
+	for _, format := range m.Formats {
+		if _, ok := renderers[format]; !ok {
+			return fmt.Errorf("unknown format %q, expected one of %v", format, formatNames())
+		}
+	}
+	if m.Compression != compressio.None && !contains(compressio.Names, m.Compression) {
+		return fmt.Errorf("unknown compression %q, expected one of %v", m.Compression, compressio.Names)
+	}
+	if err := validatePOS(m.POS); err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	for _, lang := range m.Languages {
+		if lang.Code == "" {
+			return fmt.Errorf("language without code")
+		}
+		if seen[lang.Code] {
+			return fmt.Errorf("language %v listed twice", lang.Code)
+		}
+		seen[lang.Code] = true
+		if len(lang.Files) == 0 {
+			return fmt.Errorf("language %v: no input files", lang.Code)
+		}
+		for _, f := range append(lang.Files, lang.Multiwords...) {
+			info, err := os.Stat(f)
+			if err != nil {
+				return fmt.Errorf("language %v: %v", lang.Code, err)
+			}
+			if info.IsDir() {
+				return fmt.Errorf("language %v: %v is a directory", lang.Code, f)
+			}
+		}
+		if err := validatePOS(lang.POS); err != nil {
+			return fmt.Errorf("language %v: %v", lang.Code, err)
+		}
+	}
+	return nil
+}
+
+func validatePOS(pos map[string]string) error {
+	for tag, p := range pos {
+		if tag == "" {
+			return fmt.Errorf("PoS mapping: empty tag prefix for %q", p)
+		}
+		if p == "" {
+			return fmt.Errorf("PoS mapping: empty PoS for %q", tag)
+		}
+		if _, err := lemmatizer.ParsePOS(p); err != nil {
+			return fmt.Errorf("PoS mapping: %q for %q is not a PoS of the lemmatizer", p, tag)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+// ParadigmRules derive the inflection paradigm of the lemmas of a
+// language for the Paradigms map: the class of a verb from the ending of
+// its lemma, the gender class of a noun from the tags of its forms
+type ParadigmRules struct {
+	// Endings are the lemma endings naming the paradigm of a PoS, matched
+	// against the normalized lemma, longest first: {"VERB": ["ar", "er", "ir"]}
+	Endings map[string][]string `json:"endings,omitempty"`
+	// Gender is the position of the gender (EAGLES M, F, C, N) in the
+	// tags of a PoS, 0-based: the paradigm lists the genders of all the
+	// forms of the lemma, "mf" for nouns such as niño/niña
+	Gender map[string]int `json:"gender,omitempty"`
+}
+
+// genderOrder sorts the gender letters of a paradigm
+const genderOrder = "mfcn"
+
+// paradigms accumulates the genders seen for each lemma while parsing
+type paradigms struct {
+	rules   *ParadigmRules
+	genders map[string]map[string]string // PoS, lemma, gender letters
+}
+
+func newParadigms(rules *ParadigmRules) *paradigms {
+	if rules == nil {
+		return nil
+	}
+	return &paradigms{rules: rules, genders: make(map[string]map[string]string)}
+}
+
+// add records the gender of an entry
+func (p *paradigms) add(pos, lemma, tag string) {
+	if p == nil {
+		return
+	}
+	at, ok := p.rules.Gender[pos]
+	if !ok {
+		return
+	}
+	tags := []rune(tag)
+	if at >= len(tags) {
+		return
+	}
+	g := strings.ToLower(string(tags[at]))
+	if !strings.Contains(genderOrder, g) {
+		return
+	}
+	lemmas, ok := p.genders[pos]
+	if !ok {
+		lemmas = make(map[string]string)
+		p.genders[pos] = lemmas
+	}
+	if !strings.Contains(lemmas[lemma], g) {
+		lemmas[lemma] += g
+	}
+}
+
+// build returns the Paradigms LanguageDictionary for the lemmas of
+// dictionary
+func (p *paradigms) build(dictionary *LanguageDictionary, normalize func(string) string) *LanguageDictionary {
+	langDict := &LanguageDictionary{
+		Language: dictionary.Language,
+		Name:     "Paradigms",
+		Key:      "Lemma",
+		Value:    "Paradigm",
+		Entries:  make(Dicts),
+		Counts:   make(map[string]int),
+		Skipped:  make(map[string]int),
+	}
+	for pos, dict := range dictionary.Entries {
+		endings := append([]string(nil), p.rules.Endings[pos]...)
+		sort.Slice(endings, func(i, j int) bool { return len(endings[i]) > len(endings[j]) })
+		_, gendered := p.rules.Gender[pos]
+		if len(endings) == 0 && !gendered {
+			continue
+		}
+		paradigms := make(Dict)
+		for _, lemma := range dict {
+			if _, ok := paradigms[lemma]; ok {
+				continue
+			}
+			var parts []string
+			key := normalize(lemma)
+			for _, e := range endings {
+				if strings.HasSuffix(key, e) {
+					parts = append(parts, e)
+					break
+				}
+			}
+			if g := sortGenders(p.genders[pos][lemma]); g != "" {
+				parts = append(parts, g)
+			}
+			if len(parts) > 0 {
+				paradigms[lemma] = strings.Join(parts, "-")
+			}
+		}
+		if len(paradigms) > 0 {
+			langDict.Entries[pos] = paradigms
+			langDict.Counts[pos] = len(paradigms)
+		}
+	}
+	return langDict
+}
+
+func sortGenders(genders string) string {
+	var b strings.Builder
+	for _, g := range genderOrder {
+		if strings.ContainsRune(genders, g) {
+			b.WriteRune(g)
+		}
+	}
+	return b.String()
+}
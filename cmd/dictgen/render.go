@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"github.com/lang-ai/simple_lemmatizer/internal/compressio"
+)
+
+// goTemplate is the index of the Go shards, one per PoS so that updates
+// only rewrite (and diff) the PoS that changed
+var goTemplate = template.Must(template.New("go").Funcs(template.FuncMap{"lower": strings.ToLower}).Parse(`// Code generated by dictgen; DO NOT EDIT.
+
+package {{.Language}}
+
+import lemmatizer "github.com/lang-ai/simple_lemmatizer"
+
+// map of PoS to (map of {{.Key}} to {{.Value}})
+var {{.Name}} = map[lemmatizer.POS]map[string]string{
+{{- $name := .Name}}
+{{- range  $pos, $dict := .Entries}}
+	lemmatizer.{{$pos}}: {{lower $name}}{{$pos}},{{end}}
+}
+{{- if .Register}}
+
+func init() {
+	lemmatizer.{{.Register}}({{printf "%q" .Language}}, {{.Name}})
+}
+{{- end}}
+`))
+
+var goShardTemplate = template.Must(template.New("go").Funcs(template.FuncMap{"lower": strings.ToLower}).Parse(`// Code generated by dictgen; DO NOT EDIT.
+
+package {{.Language}}
+
+// {{.Name}} entries of PoS {{.POS}}, map of {{.Key}} to {{.Value}}
+var {{lower .Name}}{{.POS}} = map[string]string{
+{{- range  $f, $l := .Entries}}
+	{{printf "%q" $f}}: {{printf "%q" $l}},{{end}}
+}
+`))
+
+// shard is the data of goShardTemplate
+type shard struct {
+	Language string
+	Name     string
+	POS      string
+	Key      string
+	Value    string
+	Entries  Dict
+}
+
+var tsTemplate = template.Must(template.New("ts").Funcs(template.FuncMap{"json": jsonString, "lower": strings.ToLower}).Parse(`// Code generated by dictgen; DO NOT EDIT.
+
+// map of PoS to (map of {{.Key}} to {{.Value}})
+export const {{lower .Name}}: { [pos: string]: { [form: string]: string } } = {
+{{- range  $pos, $dict := .Entries}}
+	{{json $pos}}: {
+		{{- range  $f, $l := $dict}}
+		{{json $f}}: {{json $l}},{{end}}
+	},{{end}}
+};
+`))
+
+// jsonString quotes s as a JSON string, which is also a valid TS literal
+func jsonString(s string) (string, error) {
+	b, err := json.Marshal(s)
+	return string(b), err
+}
+
+// artifact is a generated file, named relative to the language directory
+type artifact struct {
+	name    string
+	content []byte
+}
+
+// renderer returns the files of a parsed LanguageDictionary in one output
+// format
+type renderer func(langDict LanguageDictionary) ([]artifact, error)
+
+// singleFile renders a LanguageDictionary to <name>.<format>
+func singleFile(format string, write func(w io.Writer, langDict LanguageDictionary) error) renderer {
+	return func(langDict LanguageDictionary) ([]artifact, error) {
+		var buf bytes.Buffer
+		if err := write(&buf, langDict); err != nil {
+			return nil, err
+		}
+		return []artifact{{name: strings.ToLower(langDict.Name) + "." + format, content: buf.Bytes()}}, nil
+	}
+}
+
+// renderGo writes <name>.go, indexing the <name>_<pos>.go shards
+func renderGo(langDict LanguageDictionary) ([]artifact, error) {
+	var buf bytes.Buffer
+	if err := goTemplate.Execute(&buf, langDict); err != nil {
+		return nil, err
+	}
+	name := strings.ToLower(langDict.Name)
+	artifacts := []artifact{{name: name + ".go", content: buf.Bytes()}}
+	for pos, dict := range langDict.Entries {
+		var buf bytes.Buffer
+		data := shard{Language: langDict.Language, Name: langDict.Name, POS: pos, Key: langDict.Key, Value: langDict.Value, Entries: dict}
+		if err := goShardTemplate.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, artifact{name: name + "_" + strings.ToLower(pos) + ".go", content: buf.Bytes()})
+	}
+	sort.Slice(artifacts, func(i, j int) bool { return artifacts[i].name < artifacts[j].name })
+	return artifacts, nil
+}
+
+func writeTemplate(t *template.Template) func(w io.Writer, langDict LanguageDictionary) error {
+	return func(w io.Writer, langDict LanguageDictionary) error {
+		return t.Execute(w, langDict)
+	}
+}
+
+func renderJSON(w io.Writer, langDict LanguageDictionary) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+	return enc.Encode(langDict.Entries)
+}
+
+// renderSpacy writes lemma_lookup.json, the lookup table of the spaCy
+// lemmatizer (map of Form to Lemma, as in spacy-lookups-data). The table
+// has no PoS: the lemma of a form is that of its first PoS by name, the
+// first reading of lemmatizer.Analyze.
+func renderSpacy(langDict LanguageDictionary) ([]artifact, error) {
+	posList := make([]string, 0, len(langDict.Entries))
+	for pos := range langDict.Entries {
+		posList = append(posList, pos)
+	}
+	sort.Strings(posList)
+	table := make(map[string]string)
+	for _, pos := range posList {
+		for form, lemma := range langDict.Entries[pos] {
+			if _, ok := table[form]; !ok {
+				table[form] = lemma
+			}
+		}
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(table); err != nil {
+		return nil, err
+	}
+	return []artifact{{name: "lemma_lookup.json", content: buf.Bytes()}}, nil
+}
+
+// lemdictRenderer renders <name>.lemdict, compressed with compression
+// (see compressio.NewWriter)
+func lemdictRenderer(compression string) renderer {
+	return singleFile("lemdict", func(w io.Writer, langDict LanguageDictionary) error {
+		cw, err := compressio.NewWriter(w, compression)
+		if err != nil {
+			return err
+		}
+		if err := renderLemdict(cw, langDict); err != nil {
+			cw.Close()
+			return err
+		}
+		return cw.Close()
+	})
+}
+
+func renderLemdict(w io.Writer, langDict LanguageDictionary) error {
+	dictionary, err := posDictionary(langDict.Entries)
+	if err != nil {
+		return err
+	}
+	return lemmatizer.WriteLemdict(w, langDict.Language, dictionary)
+}
+
+// posDictionary returns entries keyed by lemmatizer.POS
+func posDictionary(entries Dicts) (map[lemmatizer.POS]map[string]string, error) {
+	dictionary := make(map[lemmatizer.POS]map[string]string, len(entries))
+	for name, dict := range entries {
+		pos, err := lemmatizer.ParsePOS(name)
+		if err != nil {
+			return nil, err
+		}
+		dictionary[pos] = dict
+	}
+	return dictionary, nil
+}
+
+// renderers by format name, which is also the extension of the output
+// files
+var renderers = map[string]renderer{
+	"go":      renderGo,
+	"json":    singleFile("json", renderJSON),
+	"lemdict": lemdictRenderer(compressio.None),
+	"spacy":   renderSpacy,
+	"ts":      singleFile("ts", writeTemplate(tsTemplate)),
+}
+
+// render writes the artifacts of langDict in format to dir, skipping the
+// files whose content did not change, and removes the shards
+// (<name>_*.<format>) it no longer generates. It returns the number of
+// files written. With check nothing is written: the files that would
+// change are reported and counted instead.
+func render(dir, format string, r renderer, langDict LanguageDictionary, check bool) (int, error) {
+	artifacts, err := r(langDict)
+	if err != nil {
+		return 0, fmt.Errorf("render %v %v: %v", langDict.Language, format, err)
+	}
+	if !check {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return 0, err
+		}
+	}
+	written := 0
+	generated := make(map[string]bool, len(artifacts))
+	for _, a := range artifacts {
+		outFile := filepath.Join(dir, a.name)
+		generated[outFile] = true
+		if previous, err := ioutil.ReadFile(outFile); err == nil && bytes.Equal(previous, a.content) {
+			if check {
+				fmt.Printf("ok   %v\n", outFile)
+			}
+			continue
+		}
+		written++
+		if check {
+			fmt.Printf("FAIL %v differs\n", outFile)
+			continue
+		}
+		if err := ioutil.WriteFile(outFile, a.content, os.ModePerm); err != nil {
+			return written, err
+		}
+	}
+	stale, err := filepath.Glob(filepath.Join(dir, strings.ToLower(langDict.Name)+"_*."+format))
+	if err != nil {
+		return written, err
+	}
+	for _, f := range stale {
+		if generated[f] {
+			continue
+		}
+		written++
+		if check {
+			fmt.Printf("FAIL %v is no longer generated\n", f)
+			continue
+		}
+		if err := os.Remove(f); err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// formatNames returns the known formats, sorted
+func formatNames() string {
+	known := make([]string, 0, len(renderers))
+	for k := range renderers {
+		known = append(known, k)
+	}
+	sort.Strings(known)
+	return strings.Join(known, ", ")
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"github.com/lang-ai/simple_lemmatizer/internal/compressio"
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+// runStats is the stats command: the lemmatizer.Stats of the dictionaries
+// of .lemdict or .json artifacts, or of the languages of the manifest
+// parsed from their sources
+//
+//	dictgen stats dist/es/dictionary.lemdict release-1.2/es/dictionary.json
+//	dictgen stats -manifest dictgen.json -lang es -json
+func runStats(args []string) {
+	flags := flag.NewFlagSet("stats", flag.ExitOnError)
+	manifestFlag := flags.String("manifest", "dictgen.json", "JSON manifest of the languages reported when no files are given")
+	langFlag := flags.String("lang", "", "only report this language of the manifest")
+	jsonFlag := flags.Bool("json", false, "write the stats as JSON, one object per dictionary")
+	flags.Parse(args)
+
+	var stats []lemmatizer.Stats
+	if flags.NArg() > 0 {
+		for _, fileName := range flags.Args() {
+			s, err := fileStats(fileName)
+			if err != nil {
+				log.Fatal(err)
+			}
+			stats = append(stats, s)
+		}
+	} else {
+		m, err := buildManifest(moduleRoot(), *manifestFlag, *langFlag, "")
+		if err != nil {
+			log.Fatal(err)
+		}
+		m.setDefaults()
+		if err := m.validate(); err != nil {
+			log.Fatal(err)
+		}
+		report := &Report{MaxErrors: m.MaxErrors}
+		for _, lang := range m.Languages {
+			langDicts, err := loadLangDicts(m, lang, report)
+			if err != nil {
+				report.print(os.Stderr)
+				log.Fatal(err)
+			}
+			dictionary, err := posDictionary(langDicts[0].Entries) // the Dictionary
+			if err != nil {
+				log.Fatal(err)
+			}
+			stats = append(stats, lemmatizer.DictionaryStats(lang.Code, dictionary))
+		}
+		if len(report.Errors) > 0 {
+			report.print(os.Stderr)
+		}
+	}
+
+	if *jsonFlag {
+		enc := json.NewEncoder(os.Stdout)
+		for _, s := range stats {
+			if err := enc.Encode(s); err != nil {
+				log.Fatal(err)
+			}
+		}
+		return
+	}
+	for _, s := range stats {
+		printStats(s)
+	}
+}
+
+// fileStats returns the Stats of a .lemdict file, or of a .json artifact
+// in <lang>/ (see renderJSON)
+func fileStats(fileName string) (lemmatizer.Stats, error) {
+	if filepath.Ext(fileName) != ".json" {
+		d, err := lemmatizer.LoadLemdict(fileName)
+		if err != nil {
+			return lemmatizer.Stats{}, err
+		}
+		return lemmatizer.DictionaryStats(d.Language, d.Dictionary), nil
+	}
+	content, err := compressio.ReadFile(fileName)
+	if err != nil {
+		return lemmatizer.Stats{}, err
+	}
+	var entries Dicts
+	if err := json.Unmarshal(textio.TrimBOM(content), &entries); err != nil {
+		return lemmatizer.Stats{}, fmt.Errorf("parse %v: %v", fileName, err)
+	}
+	dictionary, err := posDictionary(entries)
+	if err != nil {
+		return lemmatizer.Stats{}, fmt.Errorf("%v: %v", fileName, err)
+	}
+	lang := filepath.Base(filepath.Dir(fileName))
+	return lemmatizer.DictionaryStats(lang, dictionary), nil
+}
+
+func printStats(s lemmatizer.Stats) {
+	posList := make([]lemmatizer.POS, 0, len(s.ByPOS))
+	for pos := range s.ByPOS {
+		posList = append(posList, pos)
+	}
+	sort.Slice(posList, func(i, j int) bool { return posList[i] < posList[j] })
+	fmt.Printf("%v\n%-8v %10v %10v %10v %10v\n", s.Language, "PoS", "forms", "lemmas", "ambiguous", "MB")
+	for _, pos := range posList {
+		ps := s.ByPOS[pos]
+		fmt.Printf("%-8v %10v %10v %10v %10.1f\n", pos, ps.Forms, ps.Lemmas, ps.Ambiguous, megabytes(ps.Bytes))
+	}
+	fmt.Printf("%-8v %10v %10v %10v %10.1f\n", "total", s.Forms, s.Lemmas, s.Ambiguous, megabytes(s.Bytes))
+}
+
+func megabytes(bytes int64) float64 {
+	return float64(bytes) / (1 << 20)
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"github.com/lang-ai/simple_lemmatizer/internal/compressio"
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+// Analogies are learned from the last maxSuffix runes of the forms, and
+// leave stems of at least minStem runes. Candidates keep maxContexts
+// contexts of their form, as the miss logs.
+const (
+	maxSuffix   = 7
+	minStem     = 2
+	maxContexts = 3
+)
+
+// Candidate is an entry suggest proposes for a form of the miss logs
+type Candidate struct {
+	Form  string `json:"form"`
+	Lemma string `json:"lemma"`
+	Tag   string `json:"tag"`
+	// Count is the number of times the form was missed
+	Count int `json:"count"`
+	// Suffix is the ending the form shares with the entries the candidate
+	// is learned from, Support the share of those entries having its
+	// lemma ending and tag
+	Suffix   string   `json:"suffix"`
+	Support  float64  `json:"support"`
+	Contexts []string `json:"contexts,omitempty"`
+}
+
+// analogy turns a form ending into a lemma ending, with a tag: cantamos
+// cantar VMIP1P0 is "mos" to "r"
+type analogy struct {
+	strip, add, tag string
+}
+
+// analogies are the analogies of the entries of a language, counted per
+// form suffix
+type analogies struct {
+	forms    map[string]bool
+	bySuffix map[string]map[analogy]int
+}
+
+// runSuggest is the suggest command: candidate entries for the forms of
+// miss logs (see lemmatizer.MissLog), by analogy with the entries of the
+// manifest whose forms end the same way. The candidates are entries in
+// the format of the sources, to review and add to them; -json writes
+// them with the counts and contexts of the forms.
+//
+//	dictgen suggest -lang es misses.jsonl > candidates.txt
+//	dictgen suggest -lang es -min-count 5 -json misses.jsonl.*
+func runSuggest(args []string) {
+	flags := flag.NewFlagSet("suggest", flag.ExitOnError)
+	manifestFlag := flags.String("manifest", "dictgen.json", "JSON manifest with the sources of the language")
+	langFlag := flags.String("lang", "", "language of the miss logs")
+	minCount := flags.Int("min-count", 1, "skip the forms missed fewer times")
+	maxCandidates := flags.Int("candidates", 3, "candidates per form")
+	minSupport := flags.Float64("min-support", 0.2, "skip the candidates with less support, from 0 to 1")
+	jsonFlag := flags.Bool("json", false, "write the candidates as JSON, one object per line")
+	flags.Parse(args)
+	if *langFlag == "" || flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	m, err := buildManifest(moduleRoot(), *manifestFlag, *langFlag, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+	m.setDefaults()
+	if err := m.validate(); err != nil {
+		log.Fatal(err)
+	}
+	if len(m.Languages) == 0 {
+		log.Fatalf("language %v not in the manifest", *langFlag)
+	}
+	a, err := loadAnalogies(m.Languages[0].Files, m.Delimiter)
+	if err != nil {
+		log.Fatal(err)
+	}
+	misses, err := readMisses(flags.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	forms, known, candidates := 0, 0, 0
+	for _, miss := range misses {
+		if miss.Count < *minCount {
+			continue
+		}
+		forms++
+		if a.forms[miss.Form] {
+			known++
+			continue
+		}
+		for _, c := range a.suggest(miss, *maxCandidates, *minSupport) {
+			candidates++
+			if *jsonFlag {
+				err = enc.Encode(c)
+			} else {
+				_, err = fmt.Println(strings.Join([]string{c.Form, c.Lemma, c.Tag}, m.Delimiter))
+			}
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%v forms, %v in the dictionary already, %v candidates\n", forms, known, candidates)
+}
+
+// readMisses reads the miss logs, lowercasing the forms and summing the
+// counts of those missed for any PoS, and skips the forms that are not
+// words. Misses are sorted by decreasing count.
+func readMisses(fileNames []string) ([]lemmatizer.Miss, error) {
+	merged := make(map[string]*lemmatizer.Miss)
+	for _, fileName := range fileNames {
+		f, err := os.Open(fileName)
+		if err != nil {
+			return nil, err
+		}
+		misses, err := lemmatizer.ReadMissLog(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", fileName, err)
+		}
+		for _, miss := range misses {
+			form := strings.ToLower(miss.Form)
+			if !isWord(form) {
+				continue
+			}
+			m, ok := merged[form]
+			if !ok {
+				m = &lemmatizer.Miss{Form: form}
+				merged[form] = m
+			}
+			m.Count += miss.Count
+			for _, context := range miss.Contexts {
+				if len(m.Contexts) < maxContexts && !contains(m.Contexts, context) {
+					m.Contexts = append(m.Contexts, context)
+				}
+			}
+		}
+	}
+	misses := make([]lemmatizer.Miss, 0, len(merged))
+	for _, m := range merged {
+		misses = append(misses, *m)
+	}
+	sort.Slice(misses, func(i, j int) bool {
+		if misses[i].Count != misses[j].Count {
+			return misses[i].Count > misses[j].Count
+		}
+		return misses[i].Form < misses[j].Form
+	})
+	return misses, nil
+}
+
+// isWord reports whether form is letters, with inner hyphens, and long
+// enough to learn its entry by analogy
+func isWord(form string) bool {
+	if utf8.RuneCountInString(form) <= minStem || strings.HasPrefix(form, "-") || strings.HasSuffix(form, "-") {
+		return false
+	}
+	for _, r := range form {
+		if !unicode.IsLetter(r) && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// loadAnalogies reads the entries of the source files, form lemma tag
+// lines, and counts their analogies under the suffixes of their forms
+// that contain the ending stripped. The invalid entries, which the
+// generation reports, are skipped.
+func loadAnalogies(files []string, delimiter string) (*analogies, error) {
+	a := &analogies{forms: make(map[string]bool), bySuffix: make(map[string]map[analogy]int)}
+	for _, fileName := range files {
+		content, err := compressio.ReadFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range textio.Lines(content) {
+			fields := strings.Split(entry, delimiter)
+			if len(fields) != 3 || fields[0] == "" || fields[1] == "" || fields[2] == "" {
+				continue
+			}
+			a.add(fields[0], fields[1], fields[2])
+		}
+	}
+	return a, nil
+}
+
+func (a *analogies) add(form, lemma, tag string) {
+	form, lemma = strings.ToLower(form), strings.ToLower(lemma)
+	a.forms[form] = true
+	prefix := commonPrefix(form, lemma)
+	an := analogy{strip: form[len(prefix):], add: lemma[len(prefix):], tag: tag}
+	runes := []rune(form)
+	from := utf8.RuneCountInString(an.strip)
+	if from == 0 {
+		from = 1
+	}
+	for n := from; n <= maxSuffix && n <= len(runes)-minStem; n++ {
+		suffix := string(runes[len(runes)-n:])
+		counts, ok := a.bySuffix[suffix]
+		if !ok {
+			counts = make(map[analogy]int)
+			a.bySuffix[suffix] = counts
+		}
+		counts[an]++
+	}
+}
+
+// suggest returns the candidates for miss learned from the entries
+// sharing the longest suffix with it, their analogies by decreasing
+// count, up to max of them with at least minSupport
+func (a *analogies) suggest(miss lemmatizer.Miss, max int, minSupport float64) []Candidate {
+	runes := []rune(miss.Form)
+	for n := len(runes) - minStem; n > 0; n-- {
+		if n > maxSuffix {
+			continue
+		}
+		suffix := string(runes[len(runes)-n:])
+		counts := a.bySuffix[suffix]
+		if len(counts) == 0 {
+			continue
+		}
+		total := 0
+		list := make([]analogy, 0, len(counts))
+		for an, count := range counts {
+			total += count
+			list = append(list, an)
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if counts[list[i]] != counts[list[j]] {
+				return counts[list[i]] > counts[list[j]]
+			}
+			if list[i].tag != list[j].tag {
+				return list[i].tag < list[j].tag
+			}
+			return list[i].add < list[j].add
+		})
+		var candidates []Candidate
+		for _, an := range list {
+			support := float64(counts[an]) / float64(total)
+			if len(candidates) == max || support < minSupport {
+				break
+			}
+			candidates = append(candidates, Candidate{
+				Form:     miss.Form,
+				Lemma:    strings.TrimSuffix(miss.Form, an.strip) + an.add,
+				Tag:      an.tag,
+				Count:    miss.Count,
+				Suffix:   suffix,
+				Support:  support,
+				Contexts: miss.Contexts,
+			})
+		}
+		return candidates
+	}
+	return nil
+}
+
+// commonPrefix returns the longest prefix of a and b, whole runes
+func commonPrefix(a, b string) string {
+	i := 0
+	for i < len(a) && i < len(b) {
+		r, size := utf8.DecodeRuneInString(a[i:])
+		if s, _ := utf8.DecodeRuneInString(b[i:]); r != s {
+			break
+		}
+		i += size
+	}
+	return a[:i]
+}
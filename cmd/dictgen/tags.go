@@ -0,0 +1,76 @@
+package main
+
+import "strings"
+
+// tags accumulates the tags of each form while parsing, those of the
+// entries whose lemma is the one the Dictionary keeps for the form
+type tags struct {
+	forms map[string]map[string][]string // PoS, form, tags
+	// normalized are the tags under the normalized forms, for the keys
+	// that are not forms themselves: canto has the tags of canto, not
+	// those of cantó
+	normalized map[string]map[string][]string
+}
+
+func newTags() *tags {
+	return &tags{forms: make(map[string]map[string][]string), normalized: make(map[string]map[string][]string)}
+}
+
+// add records the tag of an entry under its form and its normalized form
+// when dict, the Dictionary entries of pos, has lemma for them
+func (t *tags) add(dict Dict, pos, form, lemma, tag string, normalize func(string) string) {
+	if t == nil {
+		return
+	}
+	addTag(t.forms, dict, pos, form, lemma, tag)
+	if key := normalize(form); key != form {
+		addTag(t.normalized, dict, pos, key, lemma, tag)
+	}
+}
+
+// addTag adds tag to the tags of key in m when dict has lemma for it
+func addTag(m map[string]map[string][]string, dict Dict, pos, key, lemma, tag string) {
+	if dict[key] != lemma {
+		return
+	}
+	forms, ok := m[pos]
+	if !ok {
+		forms = make(map[string][]string)
+		m[pos] = forms
+	}
+	if !contains(forms[key], tag) {
+		forms[key] = append(forms[key], tag)
+	}
+}
+
+// build returns the Tags LanguageDictionary: the value of a form is its
+// tags, separated by spaces ("VMII1S0 VMII3S0"). Its Go index registers
+// it, language packages don't need to.
+func (t *tags) build(lang string) *LanguageDictionary {
+	langDict := &LanguageDictionary{
+		Language: lang,
+		Name:     "Tags",
+		Key:      "Form",
+		Value:    "Tags",
+		Register: "RegisterTags",
+		Entries:  make(Dicts),
+		Counts:   make(map[string]int),
+		Skipped:  make(map[string]int),
+	}
+	for _, m := range []map[string]map[string][]string{t.forms, t.normalized} {
+		for pos, forms := range m {
+			dict, ok := langDict.Entries[pos]
+			if !ok {
+				dict = make(Dict, len(forms))
+				langDict.Entries[pos] = dict
+			}
+			for form, tags := range forms {
+				if _, ok := dict[form]; !ok {
+					dict[form] = strings.Join(tags, " ")
+				}
+			}
+			langDict.Counts[pos] = len(dict)
+		}
+	}
+	return langDict
+}
@@ -0,0 +1,118 @@
+// Command eval measures lemma accuracy against a gold-standard corpus,
+// either CoNLL-U or a two-column (form, lemma[, UPOS]) tab separated file:
+//
+//	eval -lang es -gold es_ancora-ud-test.conllu
+//	eval -lang es -gold gold.tsv -derivations -fuzzy 1 -json
+//
+// It reports overall, per-PoS, per-strategy, in-vocabulary and OOV
+// accuracy, the OOV rate and the most frequent errors. With -fill it
+// writes the CoNLL-U file to stdout with the lemmas filled in instead:
+//
+//	eval -lang es -gold raw.conllu -fill -misc > lemmatized.conllu
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	_ "github.com/lang-ai/simple_lemmatizer/de"
+	_ "github.com/lang-ai/simple_lemmatizer/es"
+	"github.com/lang-ai/simple_lemmatizer/eval"
+	_ "github.com/lang-ai/simple_lemmatizer/fr"
+)
+
+func main() {
+	lang := flag.String("lang", "", "language of the corpus")
+	gold := flag.String("gold", "", "gold-standard file")
+	format := flag.String("format", "", "conllu or tsv, guessed from the extension when empty")
+	worst := flag.Int("worst", 20, "number of most frequent errors to report")
+	asJSON := flag.Bool("json", false, "write the report as JSON")
+	derivations := flag.Bool("derivations", false, "enable the derivation fallback")
+	clitics := flag.Bool("clitics", false, "enable the clitic stripping fallback")
+	historical := flag.Bool("historical", false, "respell the historical spellings of unknown forms")
+	suffixes := flag.Bool("suffixes", false, "enable the suffix guessing fallback")
+	compounds := flag.Bool("compounds", false, "enable compound splitting")
+	fuzzy := flag.Int("fuzzy", 0, "enable fuzzy matching within this distance")
+	numbers := flag.Bool("numbers", false, "enable number lemmatization")
+	fill := flag.Bool("fill", false, "write the CoNLL-U file with the lemmas filled to stdout instead of evaluating")
+	overwrite := flag.Bool("overwrite", false, "with -fill, replace the lemmas already in the file")
+	misc := flag.Bool("misc", false, "with -fill, write the provenance of the lemmas into the MISC column")
+	flag.Parse()
+	if *lang == "" || *gold == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var opts []lemmatizer.Option
+	if *derivations {
+		opts = append(opts, lemmatizer.WithDerivations())
+	}
+	if *clitics {
+		opts = append(opts, lemmatizer.WithClitics())
+	}
+	if *historical {
+		opts = append(opts, lemmatizer.WithHistorical())
+	}
+	if *suffixes {
+		opts = append(opts, lemmatizer.WithSuffixes())
+	}
+	if *compounds {
+		opts = append(opts, lemmatizer.WithCompounds())
+	}
+	if *fuzzy > 0 {
+		opts = append(opts, lemmatizer.WithFuzzy(*fuzzy))
+	}
+	if *numbers {
+		opts = append(opts, lemmatizer.WithNumbers(lemmatizer.NumbersKeep))
+	}
+	l, err := lemmatizer.NewFor(*lang, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	f, err := os.Open(*gold)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	if *format == "" {
+		*format = "tsv"
+		if strings.HasSuffix(*gold, ".conllu") {
+			*format = "conllu"
+		}
+	}
+	if *fill {
+		if err := eval.FillCoNLLU(l, f, os.Stdout, eval.FillOptions{Overwrite: *overwrite, Provenance: *misc}); err != nil {
+			log.Fatalf("%v: %v", *gold, err)
+		}
+		return
+	}
+	var tokens []eval.Token
+	switch *format {
+	case "conllu":
+		tokens, err = eval.ReadCoNLLU(f)
+	case "tsv":
+		tokens, err = eval.ReadTwoColumn(f)
+	default:
+		log.Fatalf("unknown format %q, expected conllu or tsv", *format)
+	}
+	if err != nil {
+		log.Fatalf("%v: %v", *gold, err)
+	}
+
+	report := eval.Evaluate(l, tokens, *worst)
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "\t")
+		err = enc.Encode(report)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
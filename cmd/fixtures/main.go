@@ -0,0 +1,136 @@
+// Command fixtures runs the fixture texts of every registered language
+// through the pipeline and compares the result with the golden outputs:
+//
+//	fixtures -dir fixtures          # check, exit status 1 on differences
+//	fixtures -dir fixtures -update  # rewrite the golden files
+//
+// See fixtures/Readme.md for the file formats.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	_ "github.com/lang-ai/simple_lemmatizer/de"
+	_ "github.com/lang-ai/simple_lemmatizer/es"
+	_ "github.com/lang-ai/simple_lemmatizer/fr"
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+func main() {
+	dir := flag.String("dir", "fixtures", "fixtures directory, one subdirectory per language")
+	update := flag.Bool("update", false, "rewrite the golden files instead of comparing")
+	flag.Parse()
+
+	failed := false
+	for _, lang := range lemmatizer.Languages() {
+		texts, err := filepath.Glob(filepath.Join(*dir, lang, "*.txt"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(texts) == 0 {
+			fmt.Printf("FAIL %v: no fixtures in %v\n", lang, filepath.Join(*dir, lang))
+			failed = true
+			continue
+		}
+		p, err := lemmatizer.GetPipeline(lang)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, text := range texts {
+			ok, err := check(p, text, *update)
+			if err != nil {
+				log.Fatal(err)
+			}
+			failed = failed || !ok
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// check lemmatizes text and compares (or replaces) its golden file
+func check(p *lemmatizer.Pipeline, text string, update bool) (bool, error) {
+	content, err := ioutil.ReadFile(text)
+	if err != nil {
+		return false, err
+	}
+	got := render(p, content)
+	golden := strings.TrimSuffix(text, ".txt") + ".golden"
+	if update {
+		fmt.Printf("update %v\n", golden)
+		return true, ioutil.WriteFile(golden, got, 0644)
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		return false, err
+	}
+	if want = normalize(want); bytes.Equal(got, want) {
+		fmt.Printf("ok   %v\n", text)
+		return true, nil
+	}
+	fmt.Printf("FAIL %v\n", text)
+	reportDiff(got, want)
+	return false, nil
+}
+
+// normalize turns the line endings of a golden file checked out on
+// Windows into LF
+func normalize(golden []byte) []byte {
+	var b bytes.Buffer
+	for _, line := range textio.Lines(golden) {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return b.Bytes()
+}
+
+// render lemmatizes the sentences of content in the golden format
+func render(p *lemmatizer.Pipeline, content []byte) []byte {
+	var b bytes.Buffer
+	for _, sentence := range textio.Lines(content) {
+		tokens := strings.Fields(sentence)
+		if len(tokens) == 0 {
+			continue
+		}
+		for _, t := range p.Lemmatize(tokens) {
+			readings := make([]string, 0, len(t.Analyses))
+			for _, a := range t.Analyses {
+				readings = append(readings, a.POS.String()+":"+a.Lemma)
+			}
+			if len(readings) == 0 {
+				readings = append(readings, "-")
+			}
+			fmt.Fprintf(&b, "%v\t%v\n", t.Form, strings.Join(readings, " "))
+		}
+		b.WriteString("\n")
+	}
+	return b.Bytes()
+}
+
+// reportDiff prints the first lines that differ
+func reportDiff(got, want []byte) {
+	const maxLines = 10
+	g := bufio.NewScanner(bytes.NewReader(got))
+	w := bufio.NewScanner(bytes.NewReader(want))
+	shown := 0
+	for line := 1; shown < maxLines; line++ {
+		gok, wok := g.Scan(), w.Scan()
+		if !gok && !wok {
+			return
+		}
+		if g.Text() != w.Text() || gok != wok {
+			fmt.Printf("  line %v:\n    got  %q\n    want %q\n", line, g.Text(), w.Text())
+			shown++
+		}
+	}
+}
@@ -0,0 +1,316 @@
+// Command fuzz feeds random input, mutated dictionary forms and text
+// with malformed UTF-8, combining marks, compatibility characters and
+// the punctuation the splitter cares about, to the normalizers, the
+// splitter and the lookups of every registered language, checking their
+// invariants:
+//
+//   - nothing panics
+//   - normalizing is idempotent and keeps valid UTF-8 valid
+//   - lemmas are valid UTF-8, and lemmatizing a lemma of the dictionary
+//     gives it back, or a lemma that does when the lemma is also a form
+//     of another one (German gefallen, also a form of fallen)
+//   - LemmatizeBytes agrees with Lemma
+//   - tokens point at their text, in bytes and in runes, and Detokenize
+//     gives the text back
+//
+// Runs are deterministic: a failure is reproduced by running again with
+// the seed it reports.
+//
+//	fuzz -n 5000 -seed 1
+//	fuzz -lang es -seed 1618
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	_ "github.com/lang-ai/simple_lemmatizer/de"
+	_ "github.com/lang-ai/simple_lemmatizer/es"
+	_ "github.com/lang-ai/simple_lemmatizer/fr"
+)
+
+// pieces are inserted into the forms: combining marks, fullwidth and
+// compatibility forms, letters changing length or meaning with case,
+// apostrophes, sentence marks, odd whitespace, emoji and bytes that are
+// not UTF-8, some of them rune prefixes
+var pieces = []string{
+	"\u0301", "\u0308", "\u0327", "\u0303", "ﬁ", "ﬀ", "Ａ", "ｂ", "①", "²",
+	"ß", "ẞ", "ς", "Σ", "œ", "Æ", "İ", "ı", "ǅ", "\u2126", "\u212a",
+	"’", "‘", "ʼ", "`", "´", "'",
+	".", "...", "…", "¿", "?", "!", "¡", "«", "»", "\"", "(", ")", "-", ",",
+	" ", "  ", "\t", "\n", "\u00a0", "\u200b", "\u200d", "\ufeff", "\ufffd",
+	"😀", "👍🏽", "🇪🇸", "\x00", "\xff", "\xc3", "\xe2\x80", "\x80", "\xed\xa0\x80",
+	"1.000", "3ª", "1.º", "23", "Sr.", "EE.UU.",
+	"https://", "www.", "@", "#", "/10/", "-2026",
+}
+
+type fuzzer struct {
+	rnd      *rand.Rand
+	lang     string
+	forms    []string
+	posList  []lemmatizer.POS
+	base     *lemmatizer.Lemmatizer // dictionary only
+	full     *lemmatizer.Lemmatizer // every fallback
+	pipeline *lemmatizer.Pipeline
+	failures int
+}
+
+func main() {
+	n := flag.Int("n", 1000, "inputs per language")
+	seed := flag.Int64("seed", 1, "seed of the random inputs")
+	langFlag := flag.String("lang", "", "only fuzz this language")
+	fuzzy := flag.Int("fuzzy", 1, "distance of the fuzzy fallback, 0 to leave it out")
+	flag.Parse()
+
+	failures := 0
+	for _, lang := range lemmatizer.Languages() {
+		if *langFlag != "" && lang != *langFlag {
+			continue
+		}
+		f, err := newFuzzer(lang, *seed, *fuzzy)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		for i := 0; i < *n; i++ {
+			f.run(i)
+		}
+		fmt.Printf("%v: %v inputs, %v failures\n", lang, *n, f.failures)
+		failures += f.failures
+	}
+	if failures > 0 {
+		fmt.Printf("reproduce with -seed %v\n", *seed)
+		os.Exit(1)
+	}
+}
+
+func newFuzzer(lang string, seed int64, fuzzy int) (*fuzzer, error) {
+	base, err := lemmatizer.NewFor(lang)
+	if err != nil {
+		return nil, err
+	}
+	full, err := lemmatizer.NewFor(lang,
+		lemmatizer.WithDerivations(), lemmatizer.WithClitics(), lemmatizer.WithHistorical(), lemmatizer.WithInclusive(lemmatizer.InclusiveCanonical), lemmatizer.WithSuffixes(),
+		lemmatizer.WithCompounds(), lemmatizer.WithNumbers(lemmatizer.NumbersSpellOut),
+		lemmatizer.WithStemmer(), lemmatizer.WithFuzzy(fuzzy), lemmatizer.WithCache(1000))
+	if err != nil {
+		return nil, err
+	}
+	opts := []lemmatizer.PipelineOption{
+		lemmatizer.WithContractions(),
+		lemmatizer.WithProperNouns(lemmatizer.ProperNouns{Capitalized: true, Acronyms: true}),
+		lemmatizer.WithClassifiers(lemmatizer.AllClassifiers),
+	}
+	if m := lemmatizer.MergerFor(lang); m != nil {
+		opts = append(opts, lemmatizer.WithMerger(m))
+	}
+	if expressions := lemmatizer.Multiwords(lang); expressions != nil {
+		opts = append(opts, lemmatizer.WithMultiwords(expressions))
+	}
+	// the forms are sorted, map order would make runs differ
+	seen := make(map[string]bool)
+	var forms []string
+	var posList []lemmatizer.POS
+	for pos, dict := range lemmatizer.Dictionary(lang) {
+		posList = append(posList, pos)
+		for form := range dict {
+			if !seen[form] {
+				seen[form] = true
+				forms = append(forms, form)
+			}
+		}
+	}
+	sort.Strings(forms)
+	sort.Slice(posList, func(i, j int) bool { return posList[i] < posList[j] })
+	return &fuzzer{
+		rnd:      rand.New(rand.NewSource(seed)),
+		lang:     lang,
+		forms:    forms,
+		posList:  posList,
+		base:     base,
+		full:     full,
+		pipeline: lemmatizer.NewPipeline(full, opts...),
+	}, nil
+}
+
+// failf reports a failure of input i
+func (f *fuzzer) failf(i int, input, format string, args ...interface{}) {
+	f.failures++
+	fmt.Printf("FAIL %v #%v %q: %v\n", f.lang, i, input, fmt.Sprintf(format, args...))
+}
+
+// run checks the i-th random word and text
+func (f *fuzzer) run(i int) {
+	word := f.word()
+	text := f.text()
+	f.guard(i, word, func() { f.checkNormalizers(i, word) })
+	f.guard(i, word, func() { f.checkLookups(i, word) })
+	f.guard(i, text, func() { f.checkText(i, text) })
+}
+
+// guard runs check, reporting its panic as a failure of input
+func (f *fuzzer) guard(i int, input string, check func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			f.failf(i, input, "panic: %v", r)
+		}
+	}()
+	check()
+}
+
+// word returns a dictionary form, mutated most of the time
+func (f *fuzzer) word() string {
+	word := f.forms[f.rnd.Intn(len(f.forms))]
+	for n := f.rnd.Intn(4); n > 0; n-- {
+		switch f.rnd.Intn(7) {
+		case 0: // at a byte, which may split a rune
+			at := f.rnd.Intn(len(word) + 1)
+			word = word[:at] + f.piece() + word[at:]
+		case 1: // at a rune
+			runes := []rune(word)
+			at := f.rnd.Intn(len(runes) + 1)
+			word = string(runes[:at]) + f.piece() + string(runes[at:])
+		case 2:
+			word = strings.ToUpper(word)
+		case 3:
+			word = strings.Title(word)
+		case 4:
+			if len(word) > 0 {
+				word = word[:f.rnd.Intn(len(word))]
+			}
+		case 5:
+			word += "-" + f.forms[f.rnd.Intn(len(f.forms))]
+		case 6:
+			word = string(randomBytes(f.rnd, 1+f.rnd.Intn(12)))
+		}
+	}
+	return word
+}
+
+// text returns words and pieces joined by spaces and sentence marks
+func (f *fuzzer) text() string {
+	var b strings.Builder
+	for n := 1 + f.rnd.Intn(20); n > 0; n-- {
+		if f.rnd.Intn(3) == 0 {
+			b.WriteString(f.piece())
+		} else {
+			b.WriteString(f.word())
+		}
+		if f.rnd.Intn(4) > 0 {
+			b.WriteString(" ")
+		}
+	}
+	return b.String()
+}
+
+func (f *fuzzer) piece() string {
+	return pieces[f.rnd.Intn(len(pieces))]
+}
+
+func randomBytes(rnd *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	rnd.Read(b)
+	return b
+}
+
+// normalizers are that of the language and each step alone
+func normalizers(lang string) []lemmatizer.Normalizer {
+	return []lemmatizer.Normalizer{
+		lemmatizer.NormalizerFor(lang),
+		{Compatibility: true},
+		{FoldWidth: true},
+		{FoldApostrophes: true},
+		{FoldCase: true},
+		{FoldSharpS: true},
+		{FoldFinalSigma: true},
+		{FoldLigatures: true},
+		{StripAccents: true},
+		{Compatibility: true, FoldWidth: true, FoldApostrophes: true, FoldCase: true, FoldSharpS: true, FoldFinalSigma: true, FoldLigatures: true, StripAccents: true},
+	}
+}
+
+func (f *fuzzer) checkNormalizers(i int, word string) {
+	for _, n := range normalizers(f.lang) {
+		once := n.Normalize(word)
+		if utf8.ValidString(word) && !utf8.ValidString(once) {
+			f.failf(i, word, "%+v: invalid UTF-8 %q", n, once)
+		}
+		if twice := n.Normalize(once); twice != once {
+			f.failf(i, word, "%+v: not idempotent, %q then %q", n, once, twice)
+		}
+	}
+}
+
+func (f *fuzzer) checkLookups(i int, word string) {
+	var buf []byte
+	for _, pos := range f.posList {
+		lemma, ok := f.base.Lemma(word, pos)
+		var bytesOK bool
+		buf, bytesOK = f.base.LemmatizeBytes(buf[:0], []byte(word), pos)
+		if ok != bytesOK || lemma != string(buf) {
+			f.failf(i, word, "%v: LemmatizeBytes gives %q %v, Lemma %q %v", pos, buf, bytesOK, lemma, ok)
+		}
+		r := f.full.Lookup(word, pos)
+		if !utf8.ValidString(r.Lemma) {
+			f.failf(i, word, "%v: invalid UTF-8 lemma %q (%v)", pos, r.Lemma, r.Source)
+		}
+	}
+	for _, a := range f.base.Analyze(word) {
+		if !utf8.ValidString(a.Lemma) {
+			f.failf(i, word, "%v: invalid UTF-8 lemma %q (%v)", a.POS, a.Lemma, a.Source)
+		}
+		if a.Source != lemmatizer.SourceDictionary && a.Source != lemmatizer.SourceNormalized {
+			continue
+		}
+		again := f.base.Lookup(a.Lemma, a.POS)
+		if !again.Found || again.Lemma == a.Lemma {
+			continue
+		}
+		if third := f.base.Lookup(again.Lemma, a.POS); third.Found && third.Lemma != again.Lemma {
+			f.failf(i, word, "%v: the lemmas of lemma %q are %q then %q", a.POS, a.Lemma, again.Lemma, third.Lemma)
+		}
+	}
+	for _, a := range f.full.Analyze(word) {
+		if !utf8.ValidString(a.Lemma) {
+			f.failf(i, word, "%v: invalid UTF-8 lemma %q (%v)", a.POS, a.Lemma, a.Source)
+		}
+	}
+}
+
+func (f *fuzzer) checkText(i int, text string) {
+	var all []lemmatizer.Token
+	runes := 0
+	for _, sentence := range f.pipeline.LemmatizeText(text) {
+		for _, t := range sentence {
+			if t.Start < 0 || t.Start > t.End || t.End > len(text) || t.Text != text[t.Start:t.End] {
+				f.failf(i, text, "token %q at %v-%v", t.Text, t.Start, t.End)
+				return
+			}
+			if t.RuneStart != utf8.RuneCountInString(text[:t.Start]) || t.RuneEnd != utf8.RuneCountInString(text[:t.End]) {
+				f.failf(i, text, "token %q at runes %v-%v", t.Text, t.RuneStart, t.RuneEnd)
+			}
+			if t.RuneStart < runes {
+				f.failf(i, text, "token %q at rune %v before the previous one", t.Text, t.RuneStart)
+			}
+			runes = t.RuneStart
+			for _, a := range t.Analyses {
+				if !utf8.ValidString(a.Lemma) {
+					f.failf(i, text, "token %q: invalid UTF-8 lemma %q (%v)", t.Form, a.Lemma, a.Source)
+				}
+			}
+			all = append(all, t)
+		}
+	}
+	if len(all) == 0 {
+		return
+	}
+	if got, want := lemmatizer.Detokenize(all), text[all[0].Start:]; got != want {
+		f.failf(i, text, "Detokenize gives %q", got)
+	}
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	_ "github.com/lang-ai/simple_lemmatizer/de"
+	_ "github.com/lang-ai/simple_lemmatizer/es"
+	"github.com/lang-ai/simple_lemmatizer/eval"
+	_ "github.com/lang-ai/simple_lemmatizer/fr"
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+// manifestName is the file in the output directory listing the files done
+const manifestName = "manifest.tsv"
+
+// progressEvery is how often progress is reported
+const progressEvery = 5 * time.Second
+
+// input is a file of the corpus
+type input struct {
+	path string
+	// rel is the path relative to the base of the glob, with slashes
+	rel string
+	// stamp identifies the version of the file, see stampOf
+	stamp string
+}
+
+func corpus(args []string) {
+	flags := flag.NewFlagSet("corpus", flag.ExitOnError)
+	lang := flags.String("lang", "", "language of the corpus")
+	in := flags.String("in", "", "glob of the input files, ** matches any number of directories")
+	out := flags.String("out", "", "output directory")
+	workers := flags.Int("workers", runtime.NumCPU(), "files processed in parallel")
+	contractions := flags.Bool("contractions", false, "expand contractions")
+	classify := flags.Bool("classify", false, "keep URLs, emails, hashtags, mentions, dates and emoji as they are")
+	timeout := flags.Duration("timeout", 0, "stop after this long, 0 for no limit")
+	flags.Parse(args)
+	if *lang == "" || *in == "" || *out == "" || *workers < 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var opts []lemmatizer.PipelineOption
+	if *contractions {
+		opts = append(opts, lemmatizer.WithContractions())
+	}
+	if *classify {
+		opts = append(opts, lemmatizer.WithClassifiers(lemmatizer.AllClassifiers))
+	}
+	p, err := lemmatizer.GetPipeline(*lang, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	inputs, err := find(textio.Path(*in))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		log.Fatal(err)
+	}
+	done, err := readManifest(filepath.Join(*out, manifestName))
+	if err != nil {
+		log.Fatal(err)
+	}
+	var pending []input
+	for _, f := range inputs {
+		if done[f.rel] != f.stamp {
+			pending = append(pending, f)
+		}
+	}
+	log.Printf("%v files, %v done before, %v to lemmatize", len(inputs), len(inputs)-len(pending), len(pending))
+
+	// an interrupt or the timeout stops the workers, the files done so far
+	// stay in the manifest and the next run resumes from there
+	ctx, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+	manifest, err := os.OpenFile(filepath.Join(*out, manifestName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var (
+		mu       sync.Mutex
+		failed   int
+		files    int64
+		tokens   int64
+		jobs     = make(chan input)
+		wg       sync.WaitGroup
+		start    = time.Now()
+		progress = time.NewTicker(progressEvery)
+		stop     = make(chan struct{})
+	)
+	go func() {
+		for {
+			select {
+			case <-progress.C:
+				n := atomic.LoadInt64(&files)
+				log.Printf("%v/%v files, %.0f tokens/s", n, len(pending), float64(atomic.LoadInt64(&tokens))/time.Since(start).Seconds())
+			case <-stop:
+				return
+			}
+		}
+	}()
+	for i := 0; i < *workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range jobs {
+				n, err := lemmatizeFile(ctx, p, f, *out)
+				if err != nil && ctx.Err() != nil {
+					continue // interrupted, not failed
+				}
+				mu.Lock()
+				if err == nil {
+					_, err = fmt.Fprintf(manifest, "%v\t%v\n", f.rel, f.stamp)
+				}
+				if err != nil {
+					log.Printf("%v: %v", f.path, err)
+					failed++
+				}
+				mu.Unlock()
+				atomic.AddInt64(&files, 1)
+				atomic.AddInt64(&tokens, int64(n))
+			}
+		}()
+	}
+feed:
+	for _, f := range pending {
+		select {
+		case jobs <- f:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	progress.Stop()
+	close(stop)
+	if err := manifest.Close(); err != nil {
+		log.Fatal(err)
+	}
+	if err := ctx.Err(); err != nil {
+		done := atomic.LoadInt64(&files)
+		log.Fatalf("stopped (%v) after %v/%v files, run again to resume", err, done, len(pending))
+	}
+	log.Printf("%v files, %v tokens in %v", files, tokens, time.Since(start).Round(time.Millisecond))
+	if failed > 0 {
+		log.Fatalf("%v files failed", failed)
+	}
+}
+
+// lemmatizeFile writes the CoNLL-U file of f under out, returning its
+// number of tokens. The file is written under a temporary name and
+// renamed, so an interruption never leaves a partial output behind: once
+// ctx is done nothing is written.
+func lemmatizeFile(ctx context.Context, p *lemmatizer.Pipeline, f input, out string) (int, error) {
+	content, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return 0, err
+	}
+	sentences, err := p.LemmatizeTextContext(ctx, string(textio.TrimBOM(content)))
+	if err != nil {
+		return 0, err
+	}
+	var b bytes.Buffer
+	if err := eval.WriteCoNLLU(&b, sentences); err != nil {
+		return 0, err
+	}
+	target := filepath.Join(out, filepath.FromSlash(strings.TrimSuffix(f.rel, path.Ext(f.rel))+".conllu"))
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return 0, err
+	}
+	tmp := target + ".tmp"
+	if err := ioutil.WriteFile(tmp, b.Bytes(), 0644); err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, s := range sentences {
+		n += len(s)
+	}
+	return n, os.Rename(tmp, target)
+}
+
+// stampOf identifies the version of a file by its size and modification
+// time
+func stampOf(info os.FileInfo) string {
+	return strconv.FormatInt(info.Size(), 10) + ":" + strconv.FormatInt(info.ModTime().UnixNano(), 10)
+}
+
+// readManifest returns the stamps of the files done, by relative path. A
+// missing manifest is an empty one.
+func readManifest(name string) (map[string]string, error) {
+	done := make(map[string]string)
+	f, err := os.Open(name)
+	if os.IsNotExist(err) {
+		return done, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// a line cut short by an interruption has no tab or stamp and is
+		// ignored, the file is done again
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) == 2 && strings.Contains(fields[1], ":") {
+			done[fields[0]] = fields[1]
+		}
+	}
+	return done, scanner.Err()
+}
+
+// find returns the files matching pattern, where ** matches any number of
+// directories, sorted by path
+func find(pattern string) ([]input, error) {
+	base, rest := splitGlob(filepath.ToSlash(pattern))
+	if _, err := path.Match(rest, ""); err != nil {
+		return nil, err
+	}
+	segments := strings.Split(rest, "/")
+	var inputs []input
+	err := filepath.Walk(filepath.FromSlash(base), func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(filepath.FromSlash(base), p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if match(segments, strings.Split(rel, "/")) {
+			inputs = append(inputs, input{path: p, rel: rel, stamp: stampOf(info)})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("no files match %v", pattern)
+	}
+	return inputs, nil
+}
+
+// splitGlob splits a slash separated pattern into the directory before
+// its first wildcard and the rest
+func splitGlob(pattern string) (string, string) {
+	segments := strings.Split(pattern, "/")
+	for i, s := range segments {
+		if strings.ContainsAny(s, "*?[") {
+			base := strings.Join(segments[:i], "/")
+			if base == "" && i > 0 {
+				base = "/"
+			} else if base == "" {
+				base = "."
+			}
+			return base, strings.Join(segments[i:], "/")
+		}
+	}
+	return path.Dir(pattern), path.Base(pattern)
+}
+
+// match reports whether the segments of a path match those of a pattern
+func match(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if match(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	ok, _ := path.Match(pattern[0], segments[0])
+	return ok && match(pattern[1:], segments[1:])
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+	"github.com/lang-ai/simple_lemmatizer/internal/compressio"
+	"github.com/lang-ai/simple_lemmatizer/internal/textio"
+)
+
+// report is the coverage of the dictionary of a language over a corpus
+type report struct {
+	Language string `json:"language"`
+	// Words are the tokens with a letter, Unknown those without readings
+	Words   int     `json:"words"`
+	Unknown int     `json:"unknown"`
+	OOVRate float64 `json:"oov_rate"`
+	// Forms are the distinct words, lowercased, UnknownForms those
+	// without readings
+	Forms        int `json:"forms"`
+	UnknownForms int `json:"unknown_forms"`
+	// Top are the most frequent unknown forms
+	Top         []lemmatizer.Miss `json:"top"`
+	Suggestions []suggestion      `json:"suggestions"`
+}
+
+// suggestion is an unknown form worth adding to the dictionary: a
+// variant of it is known
+type suggestion struct {
+	Form  string         `json:"form"`
+	Lemma string         `json:"lemma"`
+	POS   lemmatizer.POS `json:"pos"`
+	Count int            `json:"count"`
+	// Reason is the variant known: "accents" without them, "clitics"
+	// without its clitics
+	Reason string `json:"reason"`
+}
+
+// coverage reports the words of the files of a corpus missing in the
+// dictionary, the most frequent ones and those a variant of which is
+// known, to guide where to expand the dictionary
+func coverage(args []string) {
+	flags := flag.NewFlagSet("coverage", flag.ExitOnError)
+	lang := flags.String("lang", "", "language of the corpus")
+	top := flags.Int("top", 50, "unknown forms listed")
+	contractions := flags.Bool("contractions", false, "expand contractions")
+	classify := flags.Bool("classify", false, "count the URLs, emails, hashtags, mentions, dates and emoji as known")
+	properNouns := flags.Bool("propernouns", false, "count the capitalized words and acronyms looking like names as known")
+	jsonFlag := flags.Bool("json", false, "write the report as JSON")
+	flags.Parse(args)
+	if *lang == "" || flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var opts []lemmatizer.PipelineOption
+	if *contractions {
+		opts = append(opts, lemmatizer.WithContractions())
+	}
+	if *classify {
+		opts = append(opts, lemmatizer.WithClassifiers(lemmatizer.AllClassifiers))
+	}
+	if *properNouns {
+		opts = append(opts, lemmatizer.WithProperNouns(lemmatizer.ProperNouns{Capitalized: true, Acronyms: true}))
+	}
+	p, err := lemmatizer.GetPipeline(*lang, opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	l, err := lemmatizer.Get(*lang)
+	if err != nil {
+		log.Fatal(err)
+	}
+	clitics, err := lemmatizer.NewFor(*lang, lemmatizer.WithClitics())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	known := make(map[string]bool)
+	unknown := make(map[string]int)
+	r := report{Language: *lang}
+	for _, name := range flags.Args() {
+		content, err := compressio.ReadFile(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, sentence := range p.LemmatizeText(string(textio.TrimBOM(content))) {
+			for _, t := range sentence {
+				if !hasLetter(t.Form) {
+					continue
+				}
+				r.Words++
+				form := strings.ToLower(t.Form)
+				if len(t.Analyses) > 0 {
+					known[form] = true
+					continue
+				}
+				r.Unknown++
+				unknown[form]++
+			}
+		}
+	}
+	for form := range unknown {
+		if known[form] { // a name passed through but at the start of a sentence
+			delete(unknown, form)
+		}
+	}
+	r.Forms = len(known) + len(unknown)
+	r.UnknownForms = len(unknown)
+	if r.Words > 0 {
+		r.OOVRate = float64(r.Unknown) / float64(r.Words)
+	}
+
+	misses := make([]lemmatizer.Miss, 0, len(unknown))
+	for form, n := range unknown {
+		misses = append(misses, lemmatizer.Miss{Form: form, Count: n})
+	}
+	sort.Slice(misses, func(i, j int) bool {
+		if misses[i].Count != misses[j].Count {
+			return misses[i].Count > misses[j].Count
+		}
+		return misses[i].Form < misses[j].Form
+	})
+	for _, m := range misses {
+		if s, ok := suggest(l, clitics, m); ok {
+			r.Suggestions = append(r.Suggestions, s)
+		}
+	}
+	if len(misses) > *top {
+		misses = misses[:*top]
+	}
+	r.Top = misses
+
+	if *jsonFlag {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(r); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	printReport(r)
+}
+
+// accentless removes the accents a normalizer keeping them leaves
+var accentless = lemmatizer.Normalizer{StripAccents: true}
+
+// suggest returns the entry suggested for an unknown form: the reading of
+// the form without accents (for languages whose normalizer keeps them)
+// or without its clitics
+func suggest(l, clitics *lemmatizer.Lemmatizer, m lemmatizer.Miss) (suggestion, bool) {
+	if variant := accentless.Normalize(m.Form); variant != m.Form {
+		if analyses := l.Analyze(variant); len(analyses) > 0 {
+			a := analyses[0]
+			return suggestion{Form: m.Form, Lemma: a.Lemma, POS: a.POS, Count: m.Count, Reason: "accents"}, true
+		}
+	}
+	for _, a := range clitics.Analyze(m.Form) {
+		if a.Source == lemmatizer.SourceClitic {
+			return suggestion{Form: m.Form, Lemma: a.Lemma, POS: a.POS, Count: m.Count, Reason: "clitics"}, true
+		}
+	}
+	return suggestion{}, false
+}
+
+func printReport(r report) {
+	fmt.Printf("%v: %v words, %v unknown (%.2f%%), %v forms, %v unknown\n", r.Language, r.Words, r.Unknown, 100*r.OOVRate, r.Forms, r.UnknownForms)
+	if len(r.Top) > 0 {
+		fmt.Printf("\ntop unknown forms\n")
+		for _, m := range r.Top {
+			fmt.Printf("%8v %v\n", m.Count, m.Form)
+		}
+	}
+	if len(r.Suggestions) > 0 {
+		fmt.Printf("\nsuggested additions (form, lemma, PoS, count, variant known)\n")
+		for _, s := range r.Suggestions {
+			fmt.Printf("%v\t%v\t%v\t%v\t%v\n", s.Form, s.Lemma, s.POS, s.Count, s.Reason)
+		}
+	}
+}
+
+func hasLetter(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+)
+
+// graph writes the graph of the forms and lemmas of the dictionary of a
+// language, and of the lemmas derived from others, to audit it
+func graph(args []string) {
+	flags := flag.NewFlagSet("graph", flag.ExitOnError)
+	lang := flags.String("lang", "", "language of the dictionary")
+	lemmas := flags.String("lemma", "", "comma separated lemmas the graph is restricted to, with their derivations and forms")
+	posFlag := flags.String("pos", "", "comma separated PoS exported, all when empty")
+	noForms := flags.Bool("no-forms", false, "leave the forms out, only the derivations")
+	format := flags.String("format", "dot", "output format: dot or json")
+	flags.Parse(args)
+	if *lang == "" || flags.NArg() > 0 || *format != "dot" && *format != "json" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	o := lemmatizer.GraphOptions{NoForms: *noForms}
+	if *lemmas != "" {
+		o.Lemmas = strings.Split(*lemmas, ",")
+	}
+	if *posFlag != "" {
+		for _, name := range strings.Split(*posFlag, ",") {
+			pos, err := lemmatizer.ParsePOS(name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			o.POS = append(o.POS, pos)
+		}
+	}
+	l, err := lemmatizer.Get(*lang)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := l.LemmaGraph(o)
+	if *format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(g)
+	} else {
+		err = g.WriteDOT(os.Stdout)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,51 @@
+// Command lemmatize lemmatizes text files. The corpus subcommand
+// lemmatizes every file matching a glob in parallel into CoNLL-U files,
+// keeping the directory structure:
+//
+//	lemmatize corpus -lang es -in 'data/**/*.txt' -out out/ -workers 8
+//
+// The files done are recorded in out/manifest.tsv, so an interrupted run
+// (Ctrl-C, SIGTERM or -timeout) started again resumes where it stopped.
+// Files changed since they were lemmatized are processed again.
+//
+// The coverage subcommand reports the words of a corpus missing in the
+// dictionary: the OOV rate, the most frequent unknown forms and those a
+// variant of which is known (without accents or clitics), suggested as
+// additions to the dictionary:
+//
+//	lemmatize coverage -lang es -top 100 corpus.txt
+//
+// The graph subcommand writes the forms of the dictionary linked to their
+// lemmas, and the lemmas to those they derive from, as DOT or JSON, to
+// audit the dictionary and spot the spurious lemmas of merged sources:
+//
+//	lemmatize graph -lang es -lemma casa,perro | dot -Tsvg > graph.svg
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	switch os.Args[1] {
+	case "corpus":
+		corpus(os.Args[2:])
+	case "coverage":
+		coverage(os.Args[2:])
+	case "graph":
+		graph(os.Args[2:])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: lemmatize corpus -lang <lang> -in <glob> -out <dir> [-workers n] [-timeout d]\n")
+	fmt.Fprintf(os.Stderr, "       lemmatize coverage -lang <lang> [-top n] [-json] <file>...\n")
+	fmt.Fprintf(os.Stderr, "       lemmatize graph -lang <lang> [-lemma l,...] [-pos p,...] [-no-forms] [-format dot|json]\n")
+	os.Exit(2)
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+)
+
+// config is read from the environment:
+//
+//	LEMMATIZER_ADDR        listen address, :8080 by default
+//	LEMMATIZER_LANGUAGES   comma separated languages to serve, or "all"
+//	LEMMATIZER_DICT_DIR    directory with <lang>/dictionary.lemdict (and
+//	                       <lang>/multiwords.lemdict) files, which take
+//	                       precedence over the embedded packs
+//	LEMMATIZER_CACHE_SIZE  fallback cache entries per language, 0 for none
+//	LEMMATIZER_TIMEOUT     time limit of a /lemmatize or /analyze request
+//	                       (10s, 500ms...), none by default
+//	LEMMATIZER_MISS_DIR    directory the words no reading was found for
+//	                       are logged to, in <lang>.jsonl miss logs, none
+//	                       by default
+type config struct {
+	addr      string
+	languages []string
+	dictDir   string
+	cacheSize int
+	timeout   time.Duration
+	missDir   string
+}
+
+// loadConfig reads and validates the configuration, reporting every
+// problem found at once
+func loadConfig(getenv func(string) string) (*config, error) {
+	c := &config{addr: getenv("LEMMATIZER_ADDR"), dictDir: getenv("LEMMATIZER_DICT_DIR"), missDir: getenv("LEMMATIZER_MISS_DIR")}
+	if c.addr == "" {
+		c.addr = ":8080"
+	}
+	var problems []string
+	if c.dictDir != "" {
+		if info, err := os.Stat(c.dictDir); err != nil || !info.IsDir() {
+			problems = append(problems, fmt.Sprintf("LEMMATIZER_DICT_DIR %q is not a directory", c.dictDir))
+			c.dictDir = ""
+		}
+	}
+	if c.missDir != "" {
+		if info, err := os.Stat(c.missDir); err != nil || !info.IsDir() {
+			problems = append(problems, fmt.Sprintf("LEMMATIZER_MISS_DIR %q is not a directory", c.missDir))
+		}
+	}
+	if size := getenv("LEMMATIZER_CACHE_SIZE"); size != "" {
+		n, err := strconv.Atoi(size)
+		if err != nil || n < 0 {
+			problems = append(problems, fmt.Sprintf("LEMMATIZER_CACHE_SIZE %q is not a number of entries", size))
+		}
+		c.cacheSize = n
+	}
+	if timeout := getenv("LEMMATIZER_TIMEOUT"); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil || d < 0 {
+			problems = append(problems, fmt.Sprintf("LEMMATIZER_TIMEOUT %q is not a duration", timeout))
+		}
+		c.timeout = d
+	}
+	available := c.available()
+	switch languages := getenv("LEMMATIZER_LANGUAGES"); languages {
+	case "":
+		problems = append(problems, "LEMMATIZER_LANGUAGES is not set")
+	case "all":
+		c.languages = available
+		if len(c.languages) == 0 {
+			problems = append(problems, "LEMMATIZER_LANGUAGES is all but no language is available")
+		}
+	default:
+		for _, lang := range strings.Split(languages, ",") {
+			lang = strings.TrimSpace(lang)
+			if lang == "" {
+				continue
+			}
+			if !contains(available, lang) {
+				problems = append(problems, fmt.Sprintf("language %q is neither embedded nor in LEMMATIZER_DICT_DIR (available: %v)", lang, strings.Join(available, ",")))
+				continue
+			}
+			c.languages = append(c.languages, lang)
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid configuration:\n\t%v", strings.Join(problems, "\n\t"))
+	}
+	return c, nil
+}
+
+// available returns the embedded languages and those in dictDir, sorted
+func (c *config) available() []string {
+	available := lemmatizer.Languages()
+	for _, lang := range c.dictDirLanguages() {
+		if !contains(available, lang) {
+			available = append(available, lang)
+		}
+	}
+	sort.Strings(available)
+	return available
+}
+
+// dictDirLanguages returns the languages with a dictionary in dictDir
+func (c *config) dictDirLanguages() []string {
+	if c.dictDir == "" {
+		return nil
+	}
+	files, _ := filepath.Glob(filepath.Join(c.dictDir, "*", "dictionary.lemdict"))
+	var languages []string
+	for _, f := range files {
+		languages = append(languages, filepath.Base(filepath.Dir(f)))
+	}
+	return languages
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,12 @@
+//go:build !lemdict_only
+// +build !lemdict_only
+
+package main
+
+// The embedded dictionary packs. Build with -tags lemdict_only for images
+// that load every language from LEMMATIZER_DICT_DIR.
+import (
+	_ "github.com/lang-ai/simple_lemmatizer/de"
+	_ "github.com/lang-ai/simple_lemmatizer/es"
+	_ "github.com/lang-ai/simple_lemmatizer/fr"
+)
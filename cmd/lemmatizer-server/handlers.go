@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+)
+
+// maxRequestBody bounds the size of a /lemmatize or /analyze request
+const maxRequestBody = 1 << 20
+
+// truncatedHeader is set, to the error of the request context, on the
+// /lemmatize and /analyze responses cut short by LEMMATIZER_TIMEOUT: the
+// tokens lemmatized until then are returned, as the library does
+const truncatedHeader = "Lemmatizer-Truncated"
+
+type analysis struct {
+	Lemma      string         `json:"lemma"`
+	POS        lemmatizer.POS `json:"pos"`
+	Paradigm   string         `json:"paradigm,omitempty"`
+	Tags       string         `json:"tags,omitempty"`
+	Inclusive  string         `json:"inclusive,omitempty"`
+	Source     string         `json:"source"`
+	Confidence float64        `json:"confidence"`
+}
+
+type token struct {
+	Form      string     `json:"form"`
+	Analyses  []analysis `json:"analyses"`
+	Multiword bool       `json:"multiword,omitempty"`
+	Truncated bool       `json:"truncated,omitempty"`
+	Rejected  bool       `json:"rejected,omitempty"`
+}
+
+type lemmatizeRequest struct {
+	Lang   string   `json:"lang"`
+	Tokens []string `json:"tokens"`
+	lookupRequest
+}
+
+// lookupRequest are the per-request options, see lemmatizer.LookupOption
+type lookupRequest struct {
+	KeepCase           bool             `json:"keep_case"`
+	PassthroughUnknown bool             `json:"passthrough_unknown"`
+	POS                []lemmatizer.POS `json:"only_pos"`
+	Strict             bool             `json:"strict"`
+	MinConfidence      float64          `json:"min_confidence"`
+}
+
+func (r lookupRequest) options() []lemmatizer.LookupOption {
+	var opts []lemmatizer.LookupOption
+	if r.KeepCase {
+		opts = append(opts, lemmatizer.KeepOriginalCase())
+	}
+	if r.PassthroughUnknown {
+		opts = append(opts, lemmatizer.PassthroughUnknown())
+	}
+	if len(r.POS) > 0 {
+		opts = append(opts, lemmatizer.OnlyPOS(r.POS...))
+	}
+	if r.Strict {
+		opts = append(opts, lemmatizer.Strict())
+	}
+	if r.MinConfidence > 0 {
+		opts = append(opts, lemmatizer.MinConfidence(r.MinConfidence))
+	}
+	return opts
+}
+
+type analyzeRequest struct {
+	Lang string `json:"lang"`
+	Text string `json:"text"`
+}
+
+// analyzeToken is a term in the format of the Elasticsearch _analyze API
+type analyzeToken struct {
+	Token       string `json:"token"`
+	StartOffset int    `json:"start_offset"`
+	EndOffset   int    `json:"end_offset"`
+	Type        string `json:"type"`
+	Position    int    `json:"position"`
+}
+
+func (s *server) handleLanguages(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.languages)
+}
+
+// handleLemma returns the readings of a form, only for pos when given.
+// keep_case=true, passthrough_unknown=true, strict=true and
+// min_confidence=<c> set those options.
+func (s *server) handleLemma(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	l, ok := s.lemmatizers[q.Get("lang")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "unknown language")
+		return
+	}
+	form := q.Get("form")
+	if form == "" {
+		writeError(w, http.StatusBadRequest, "missing form")
+		return
+	}
+	var pos lemmatizer.POS
+	if name := q.Get("pos"); name != "" {
+		var err error
+		if pos, err = lemmatizer.ParsePOS(name); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	req := lookupRequest{KeepCase: q.Get("keep_case") == "true", PassthroughUnknown: q.Get("passthrough_unknown") == "true", Strict: q.Get("strict") == "true"}
+	if c := q.Get("min_confidence"); c != "" {
+		var err error
+		if req.MinConfidence, err = strconv.ParseFloat(c, 64); err != nil {
+			writeError(w, http.StatusBadRequest, "bad min_confidence: "+err.Error())
+			return
+		}
+	}
+	opts := req.options()
+	analyses := []analysis{}
+	if pos == lemmatizer.NoPOS {
+		analyses = toAnalyses(l.Analyze(form, opts...))
+	} else if r := l.Lookup(form, pos, opts...); r.Found {
+		analyses = append(analyses, analysis{Lemma: r.Lemma, POS: pos, Paradigm: paradigm(l, r.Lemma, pos), Tags: tags(l, form, r), Inclusive: r.Inclusive, Source: r.Source.String(), Confidence: r.Confidence})
+	}
+	writeJSON(w, http.StatusOK, analyses)
+}
+
+// handleLemmatize lemmatizes a token sequence with the pipeline, the
+// tokens lemmatized so far when the request times out, see
+// truncatedHeader
+func (s *server) handleLemmatize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "use POST")
+		return
+	}
+	var req lemmatizeRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBody)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	p, ok := s.pipelines[req.Lang]
+	if !ok {
+		writeError(w, http.StatusNotFound, "unknown language")
+		return
+	}
+	ctx, cancel := s.context(r)
+	defer cancel()
+	tokens, err := p.LemmatizeContext(ctx, req.Tokens, req.options()...)
+	if err != nil {
+		w.Header().Set(truncatedHeader, err.Error())
+	}
+	result := make([]token, len(tokens))
+	for i, t := range tokens {
+		result[i] = token{Form: t.Form, Analyses: toAnalyses(t.Analyses), Multiword: t.Multiword, Truncated: t.Truncated, Rejected: t.Rejected}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleAnalyze returns the index terms of a text: the lemmas of its
+// words, several at the same position for ambiguous words, and the
+// lowercase form of unknown words. Punctuation is dropped. Offsets are
+// byte offsets into the text as sent, so they stay valid for highlighting
+// whatever char filters the search engine applied to its own copy. When
+// the request times out, the terms are those of the text lemmatized so
+// far, see truncatedHeader.
+func (s *server) handleAnalyze(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "use POST")
+		return
+	}
+	var req analyzeRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBody)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	p, ok := s.pipelines[req.Lang]
+	if !ok {
+		writeError(w, http.StatusNotFound, "unknown language")
+		return
+	}
+	ctx, cancel := s.context(r)
+	defer cancel()
+	sentences, err := p.LemmatizeTextContext(ctx, req.Text)
+	if err != nil {
+		w.Header().Set(truncatedHeader, err.Error())
+	}
+	terms := []analyzeToken{}
+	position := 0
+	for _, sentence := range sentences {
+		for _, t := range sentence {
+			if strings.IndexFunc(t.Form, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) < 0 {
+				continue
+			}
+			seen := make(map[string]bool)
+			for _, a := range t.Analyses {
+				if !seen[a.Lemma] {
+					seen[a.Lemma] = true
+					terms = append(terms, analyzeToken{Token: a.Lemma, StartOffset: t.Start, EndOffset: t.End, Type: "<LEMMA>", Position: position})
+				}
+			}
+			if len(seen) == 0 {
+				terms = append(terms, analyzeToken{Token: strings.ToLower(t.Form), StartOffset: t.Start, EndOffset: t.End, Type: "<WORD>", Position: position})
+			}
+			position++
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string][]analyzeToken{"tokens": terms})
+}
+
+// context returns the context of a pipeline request: the one of r, done
+// when the client goes away, bounded by the configured timeout
+func (s *server) context(r *http.Request) (context.Context, context.CancelFunc) {
+	if s.timeout > 0 {
+		return context.WithTimeout(r.Context(), s.timeout)
+	}
+	return context.WithCancel(r.Context())
+}
+
+// paradigm returns the paradigm of a lemma, empty when unknown
+func paradigm(l *lemmatizer.Lemmatizer, lemma string, pos lemmatizer.POS) string {
+	p, _ := l.Paradigm(lemma, pos)
+	return p
+}
+
+// tags returns the tags of a form found in the dictionary, empty for the
+// other lookups
+func tags(l *lemmatizer.Lemmatizer, form string, r lemmatizer.Lookup) string {
+	if r.Source != lemmatizer.SourceDictionary && r.Source != lemmatizer.SourceNormalized {
+		return ""
+	}
+	t, _ := l.Tags(form, r.POS)
+	return t
+}
+
+func toAnalyses(analyses []lemmatizer.Analysis) []analysis {
+	result := make([]analysis, len(analyses))
+	for i, a := range analyses {
+		result[i] = analysis{Lemma: a.Lemma, POS: a.POS, Paradigm: a.Paradigm, Tags: a.Tags, Inclusive: a.Inclusive, Source: a.Source.String(), Confidence: a.Confidence}
+	}
+	return result
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,184 @@
+// Command lemmatizer-server serves the lemmatizer over HTTP. It is
+// configured from the environment (see config) so one binary can back
+// per-language and all-language container images:
+//
+//	LEMMATIZER_LANGUAGES=es lemmatizer-server
+//	LEMMATIZER_LANGUAGES=all LEMMATIZER_DICT_DIR=/dicts lemmatizer-server
+//
+// Endpoints:
+//
+//	GET  /healthz
+//	GET  /languages
+//	GET  /lemma?lang=es&form=casas[&pos=NOUN]
+//	POST /lemmatize {"lang": "es", "tokens": ["lo", "echamos", "de", "menos"]}
+//	                [, "keep_case": true, "passthrough_unknown": true, "only_pos": ["NOUN"],
+//	                   "strict": true, "min_confidence": 0.5]
+//	POST /analyze   {"lang": "es", "text": "Las casas del río"}
+//
+// /analyze answers like the Elasticsearch _analyze API, with the lemmas
+// as terms and offsets into the text, to lemmatize at index time from an
+// ingest pipeline.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	lemmatizer "github.com/lang-ai/simple_lemmatizer"
+)
+
+type server struct {
+	lemmatizers map[string]*lemmatizer.Lemmatizer
+	pipelines   map[string]*lemmatizer.Pipeline
+	languages   []string
+	// timeout bounds the pipeline requests, see LEMMATIZER_TIMEOUT
+	timeout time.Duration
+	// missLogs are those of the pipelines, see LEMMATIZER_MISS_DIR
+	missLogs map[string]*lemmatizer.MissLog
+}
+
+// missLogFlushAt is the number of distinct misses a miss log holds in
+// memory before appending them to its file
+const missLogFlushAt = 1000
+
+// shutdownTimeout bounds the wait for the requests in flight on an
+// interrupt, before the miss logs are closed
+const shutdownTimeout = 30 * time.Second
+
+func main() {
+	c, err := loadConfig(os.Getenv)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s, err := newServer(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok\n")) })
+	mux.HandleFunc("/languages", s.handleLanguages)
+	mux.HandleFunc("/lemma", s.handleLemma)
+	mux.HandleFunc("/lemmatize", s.handleLemmatize)
+	mux.HandleFunc("/analyze", s.handleAnalyze)
+	log.Printf("serving %v on %v", s.languages, c.addr)
+
+	// an interrupt stops accepting requests, lets those in flight finish
+	// and then writes the pending misses
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	srv := &http.Server{Addr: c.addr, Handler: mux}
+	shutdown := make(chan struct{})
+	go func() {
+		defer close(shutdown)
+		<-ctx.Done()
+		log.Print("shutting down")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Print(err)
+		}
+	}()
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		s.closeMissLogs()
+		log.Fatal(err)
+	}
+	<-shutdown
+	if err := s.closeMissLogs(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// newServer loads the configured languages, from the dictionary directory
+// when it has them and from the embedded packs otherwise
+func newServer(c *config) (*server, error) {
+	s := &server{
+		lemmatizers: make(map[string]*lemmatizer.Lemmatizer),
+		pipelines:   make(map[string]*lemmatizer.Pipeline),
+		languages:   c.languages,
+		timeout:     c.timeout,
+		missLogs:    make(map[string]*lemmatizer.MissLog),
+	}
+	var opts []lemmatizer.Option
+	if c.cacheSize > 0 {
+		opts = append(opts, lemmatizer.WithCache(c.cacheSize))
+	}
+	fromDir := c.dictDirLanguages()
+	for _, lang := range c.languages {
+		var l *lemmatizer.Lemmatizer
+		multiwords := lemmatizer.Multiwords(lang)
+		if contains(fromDir, lang) {
+			dictionary, err := loadLemdict(c.dictDir, lang, "dictionary")
+			if err != nil {
+				return nil, err
+			}
+			if multiwords, err = loadLemdict(c.dictDir, lang, "multiwords"); err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+			// the paradigms, inflections and tags of the embedded pack
+			l = lemmatizer.New(lang, dictionary, append(lemmatizer.Registered(lang), opts...)...)
+			log.Printf("%v: loaded from %v", lang, c.dictDir)
+		} else {
+			var err error
+			if l, err = lemmatizer.NewFor(lang, opts...); err != nil {
+				return nil, err
+			}
+		}
+		var pipelineOpts []lemmatizer.PipelineOption
+		if m := lemmatizer.MergerFor(lang); m != nil {
+			pipelineOpts = append(pipelineOpts, lemmatizer.WithMerger(m))
+		}
+		if multiwords != nil {
+			pipelineOpts = append(pipelineOpts, lemmatizer.WithMultiwords(multiwords))
+		}
+		if c.missDir != "" {
+			m, err := lemmatizer.OpenMissLog(filepath.Join(c.missDir, lang+".jsonl"), missLogFlushAt)
+			if err != nil {
+				return nil, err
+			}
+			s.missLogs[lang] = m
+			pipelineOpts = append(pipelineOpts, lemmatizer.WithMissLog(m))
+		}
+		s.lemmatizers[lang] = l
+		s.pipelines[lang] = lemmatizer.NewPipeline(l, pipelineOpts...)
+	}
+	return s, nil
+}
+
+// closeMissLogs writes the pending misses and closes the miss logs,
+// returning the first error
+func (s *server) closeMissLogs() error {
+	var first error
+	for lang, m := range s.missLogs {
+		if err := m.Close(); err != nil {
+			log.Printf("%v: %v", lang, err)
+			if first == nil {
+				first = err
+			}
+		}
+	}
+	return first
+}
+
+// loadLemdict loads <dir>/<lang>/<name>.lemdict, checking its language.
+// The error satisfies os.IsNotExist when there is no such file.
+func loadLemdict(dir, lang, name string) (map[lemmatizer.POS]map[string]string, error) {
+	path := filepath.Join(dir, lang, name+".lemdict")
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	d, err := lemmatizer.LoadLemdict(path)
+	if err != nil {
+		return nil, err
+	}
+	if d.Language != lang {
+		return nil, fmt.Errorf("%v: dictionary of language %q, expected %q", path, d.Language, lang)
+	}
+	return d.Dictionary, nil
+}
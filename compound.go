@@ -0,0 +1,252 @@
+package lemmatizer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// compoundRules say how the compounds of a language are built
+type compoundRules struct {
+	// closed compounds are written as one word (German Haustür), see
+	// decomposeClosed
+	closed bool
+	// minPart is the length, in runes, of the shortest part of a closed
+	// compound
+	minPart int
+	// links are the linking elements between the parts of closed
+	// compounds (Arbeit-s-amt)
+	links []string
+	// inflectedModifiers are set when every part of a hyphenated compound
+	// agrees with the head (teórico-prácticas), so all are lemmatized;
+	// otherwise the modifiers stay as written (Max-Planck-Institute)
+	inflectedModifiers bool
+}
+
+var compoundRulesByLang = map[string]*compoundRules{
+	"de": {closed: true, minPart: 3, links: []string{"s", "es", "n", "en", "e", "er"}},
+	"es": {inflectedModifiers: true},
+	"fr": {inflectedModifiers: true},
+}
+
+// compoundPOS are the PoS of the compounds
+var compoundPOS = map[POS]bool{NOUN: true, ADJ: true}
+
+// modifierPOS are the PoS a part of a closed compound other than the head
+// may have
+var modifierPOS = []POS{NOUN, ADJ, VERB}
+
+// Costs of a decomposition, the cheapest one wins: few parts, few linking
+// elements and no short parts, which are often spurious (Ei, Tee)
+const (
+	partCost      = 1
+	linkCost      = 0.5
+	shortPartCost = 0.5
+	shortPart     = 4
+)
+
+// maxClosedCompound is the length, in runes, of the longest word split
+// into closed compound parts: the search is quadratic in the length, and
+// longer words are URLs or garbage rather than compounds
+// (Donaudampfschifffahrtsgesellschaft has 34 runes)
+const maxClosedCompound = 64
+
+// CompoundPart is one word of a compound
+type CompoundPart struct {
+	// Form is the part as written in the compound, lowercased
+	Form string
+	// Link is the linking element after the part, "s" in Arbeitsamt
+	Link string
+	// Lemma is the lemma of the part, empty for the modifiers kept as
+	// written
+	Lemma string
+}
+
+// WithCompounds splits the nouns and adjectives missing in the dictionary
+// into known words and lemmatizes them part by part (SourceCompound).
+// Hyphenated compounds are split at the hyphens: in Spanish and French
+// every part is lemmatized, teórico-prácticas is teórico-práctico. German
+// closed compounds are split by the cheapest decomposition into
+// dictionary words, allowing linking elements, and only the head (the
+// last part) is lemmatized: Kinderbücher is kinderbuch.
+func WithCompounds() Option {
+	return func(l *Lemmatizer) {
+		l.compounds = compoundRulesByLang[l.lang]
+		if l.compounds == nil {
+			l.compounds = &compoundRules{}
+		}
+	}
+}
+
+// Decompose returns the parts of a compound form for pos, see
+// WithCompounds. Forms in the dictionary are not decomposed.
+func (l *Lemmatizer) Decompose(form string, pos POS) ([]CompoundPart, bool) {
+	if l.compounds == nil || l.lookupExact(form, pos).Found {
+		return nil, false
+	}
+	return l.decompose(form, pos)
+}
+
+func (l *Lemmatizer) decompose(form string, pos POS) ([]CompoundPart, bool) {
+	if !compoundPOS[pos] {
+		return nil, false
+	}
+	word := strings.ToLower(form)
+	if strings.Contains(word, "-") {
+		return l.decomposeHyphenated(word, pos)
+	}
+	if l.compounds.closed {
+		return l.decomposeClosed(word, pos)
+	}
+	return nil, false
+}
+
+// compound returns the lemma of a compound form for pos
+func (l *Lemmatizer) compound(form string, pos POS) (Lookup, bool) {
+	if l.compounds == nil {
+		return Lookup{}, false
+	}
+	parts, ok := l.decompose(form, pos)
+	if !ok {
+		return Lookup{}, false
+	}
+	var b strings.Builder
+	hyphenated := strings.Contains(form, "-")
+	for i, p := range parts {
+		if i > 0 && hyphenated {
+			b.WriteByte('-')
+		}
+		if p.Lemma != "" {
+			b.WriteString(p.Lemma)
+		} else {
+			b.WriteString(p.Form)
+		}
+		b.WriteString(p.Link)
+	}
+	return Lookup{Lemma: b.String(), Found: true, Source: SourceCompound}, true
+}
+
+// decomposeHyphenated splits word at its hyphens. The head must be known;
+// the modifiers are lemmatized when the language inflects them and they
+// are known, and kept as written otherwise.
+func (l *Lemmatizer) decomposeHyphenated(word string, pos POS) ([]CompoundPart, bool) {
+	words := strings.Split(word, "-")
+	parts := make([]CompoundPart, len(words))
+	for i, w := range words {
+		if w == "" {
+			return nil, false // a prefix (ex-) or a dash, not a compound
+		}
+		parts[i].Form = w
+		if i < len(words)-1 && !l.compounds.inflectedModifiers {
+			continue
+		}
+		r := l.lookupExact(w, pos)
+		if !r.Found && i == len(words)-1 {
+			return nil, false
+		}
+		parts[i].Lemma = r.Lemma
+	}
+	return parts, true
+}
+
+// decomposeClosed finds the cheapest decomposition of word into
+// modifiers, each one a known word optionally followed by a linking
+// element, and a head of PoS pos. Ties go to the longest head. Words
+// longer than maxClosedCompound are not split.
+func (l *Lemmatizer) decomposeClosed(word string, pos POS) ([]CompoundPart, bool) {
+	if utf8.RuneCountInString(word) > maxClosedCompound {
+		return nil, false
+	}
+	rules := l.compounds
+	links := append([]string{""}, rules.links...)
+	n := len(word)
+	// best[i] is the cheapest decomposition of word[:i] into modifiers
+	type step struct {
+		cost float64
+		from int // start of the last modifier
+		link string
+		ok   bool
+	}
+	best := make([]step, n+1)
+	best[0] = step{ok: true}
+	for end := 1; end <= n; end++ {
+		if !utf8.RuneStart(byteAt(word, end)) {
+			continue
+		}
+		for start := 0; start < end; start++ {
+			if !best[start].ok || !utf8.RuneStart(word[start]) {
+				continue
+			}
+			candidate := word[start:end]
+			for _, link := range links {
+				if !strings.HasSuffix(candidate, link) {
+					continue
+				}
+				part := candidate[:len(candidate)-len(link)]
+				if utf8.RuneCountInString(part) < rules.minPart || !l.isModifier(part) {
+					continue
+				}
+				cost := best[start].cost + partCost + costOf(part)
+				if link != "" {
+					cost += linkCost
+				}
+				if !best[end].ok || cost < best[end].cost {
+					best[end] = step{cost: cost, from: start, link: link, ok: true}
+				}
+			}
+		}
+	}
+	bestHead, bestCost := -1, 0.0
+	var headLemma string
+	for start := 1; start < n; start++ {
+		head := word[start:]
+		if !best[start].ok || utf8.RuneCountInString(head) < rules.minPart {
+			continue
+		}
+		r := l.lookupExact(head, pos)
+		if !r.Found {
+			continue
+		}
+		cost := best[start].cost + partCost + costOf(head)
+		if bestHead < 0 || cost < bestCost {
+			bestHead, bestCost, headLemma = start, cost, r.Lemma
+		}
+	}
+	if bestHead < 0 {
+		return nil, false
+	}
+	parts := []CompoundPart{{Form: word[bestHead:], Lemma: headLemma}}
+	for end := bestHead; end > 0; end = best[end].from {
+		s := best[end]
+		parts = append(parts, CompoundPart{Form: word[s.from : end-len(s.link)], Link: s.link})
+	}
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return parts, true
+}
+
+// isModifier returns whether part is a word that can start a compound
+func (l *Lemmatizer) isModifier(part string) bool {
+	for _, pos := range modifierPOS {
+		if l.lookupExact(part, pos).Found {
+			return true
+		}
+	}
+	return false
+}
+
+// costOf returns the extra cost of a part for its length
+func costOf(part string) float64 {
+	if utf8.RuneCountInString(part) < shortPart {
+		return shortPartCost
+	}
+	return 0
+}
+
+// byteAt returns s[i], or a rune start past the end of s
+func byteAt(s string, i int) byte {
+	if i == len(s) {
+		return 0
+	}
+	return s[i]
+}
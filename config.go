@@ -0,0 +1,278 @@
+package lemmatizer
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Config is the configuration of a Lemmatizer as plain data, ready for
+// encoding/json or encoding/gob: the registered dictionary it builds on,
+// the entries that differ from it and its options. Saving the Config of a
+// Lemmatizer with many overrides and restoring it with NewFromConfig
+// saves rebuilding them on every cold start:
+//
+//	c, err := l.Config()
+//	...
+//	err = gob.NewEncoder(f).Encode(c)
+//	...
+//	var c lemmatizer.Config
+//	err = gob.NewDecoder(f).Decode(&c)
+//	l, err := lemmatizer.NewFromConfig(c)
+type Config struct {
+	Language string `json:"language"`
+	// Dictionary is the registered language whose dictionary the
+	// Lemmatizer builds on, empty when it has a dictionary of its own,
+	// which Overrides then hold entirely
+	Dictionary string `json:"dictionary,omitempty"`
+	// Overrides are the entries (map of PoS to map of Form to Lemma)
+	// added to the registered dictionary or changing its lemmas, those of
+	// WithOverrides included, restored with WithOverrides
+	Overrides map[POS]map[string]string `json:"overrides,omitempty"`
+	// Removed are the forms of each PoS removed from the registered
+	// dictionary
+	Removed map[POS][]string `json:"removed,omitempty"`
+	// Paradigms, Inflections and Tags are those of WithParadigms,
+	// WithInflections and WithTags when they are not the registered ones
+	Paradigms   map[POS]map[string]string `json:"paradigms,omitempty"`
+	Inflections map[POS]map[string]string `json:"inflections,omitempty"`
+	Tags        map[POS]map[string]string `json:"tags,omitempty"`
+
+	Normalizer Normalizer `json:"normalizer"`
+	// Fuzzy is the distance of WithFuzzy, 0 without fuzzy lookups
+	Fuzzy       int  `json:"fuzzy,omitempty"`
+	Derivations bool `json:"derivations,omitempty"`
+	Clitics     bool `json:"clitics,omitempty"`
+	Historical  bool `json:"historical,omitempty"`
+	Suffixes    bool `json:"suffixes,omitempty"`
+	Compounds   bool `json:"compounds,omitempty"`
+	Stemmer     bool `json:"stemmer,omitempty"`
+	// Numbers is the mode of WithNumbers, nil without it
+	Numbers *NumberMode `json:"numbers,omitempty"`
+	// Inclusive is the mode of WithInclusive, nil without it
+	Inclusive *InclusiveMode `json:"inclusive,omitempty"`
+	// Cache is the size of WithCache, 0 without a cache
+	Cache            int           `json:"cache,omitempty"`
+	DeadlinePerToken time.Duration `json:"deadline_per_token,omitempty"`
+	SingleCharacters WordPolicy    `json:"single_characters,omitempty"`
+	FunctionWords    WordPolicy    `json:"function_words,omitempty"`
+}
+
+// errFallbacks is returned by Config for Lemmatizers with WithFallbacks
+var errFallbacks = errors.New("lemmatizer: the custom fallbacks of WithFallbacks can't be saved in a Config")
+
+// errStore is returned by Config for Lemmatizers of NewFromStore
+var errStore = errors.New("lemmatizer: the dictionary of a DictStore can't be saved in a Config")
+
+// Config returns the Config of l. Custom fallbacks are code, not data, so
+// it fails for Lemmatizers with WithFallbacks: restore those with
+// NewFromConfig and the same WithFallbacks. The dictionaries of a
+// DictStore stay there, so it fails for Lemmatizers of NewFromStore too.
+func (l *Lemmatizer) Config() (Config, error) {
+	if len(l.fallbacks) > 0 {
+		return Config{}, errFallbacks
+	}
+	if l.store != nil {
+		return Config{}, errStore
+	}
+	c := Config{
+		Language:         l.lang,
+		Normalizer:       l.normalizer,
+		Fuzzy:            l.maxDistance,
+		Derivations:      l.derivation != nil,
+		Clitics:          l.clitics != nil,
+		Historical:       l.historical != nil,
+		Suffixes:         l.suffixes != nil,
+		Compounds:        l.compounds != nil,
+		Stemmer:          l.stemmer != nil,
+		DeadlinePerToken: l.tokenBudget,
+		SingleCharacters: l.singleCharacters,
+		FunctionWords:    l.functionWords,
+	}
+	if l.numbers != nil {
+		mode := l.numbers.mode
+		c.Numbers = &mode
+	}
+	if l.inclusive != nil {
+		mode := l.inclusive.mode
+		c.Inclusive = &mode
+	}
+	if l.cache != nil {
+		c.Cache = l.cache.size
+	}
+
+	registryMu.RLock()
+	base, ok := registry[l.lang]
+	registeredParadigms, registeredInflections, registeredTags := paradigms[l.lang], inflections[l.lang], tags[l.lang]
+	registryMu.RUnlock()
+	if ok {
+		c.Dictionary = l.lang
+		c.Overrides, c.Removed = diffDictionary(base, l.dictionary)
+		c.Overrides = applyOverrides(c.Overrides, l.overrides, nil)
+	} else {
+		c.Overrides = applyOverrides(l.dictionary, l.overrides, nil)
+	}
+	if !sameMap(l.paradigms, registeredParadigms) {
+		c.Paradigms = l.paradigms
+	}
+	if !sameMap(l.inflections, registeredInflections) {
+		c.Inflections = l.inflections
+	}
+	if !sameMap(l.tags, registeredTags) {
+		c.Tags = l.tags
+	}
+	return c, nil
+}
+
+// NewFromConfig returns a Lemmatizer configured as c, opts applied after
+// it. The overrides of a registered dictionary are set with
+// WithOverrides; the PoS with removed forms are copied, the others share
+// the maps of the registered dictionary.
+func NewFromConfig(c Config, opts ...Option) (*Lemmatizer, error) {
+	dictionary := c.Overrides
+	var defaults []Option
+	if c.Dictionary != "" {
+		registryMu.RLock()
+		base, ok := registry[c.Dictionary]
+		defaults = registered(c.Dictionary)
+		registryMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("lemmatizer: unknown language %q (forgotten import?)", c.Dictionary)
+		}
+		dictionary = applyOverrides(base, nil, c.Removed)
+		defaults = append(defaults, WithOverrides(c.Overrides))
+	}
+
+	options := append(defaults, WithNormalizer(c.Normalizer))
+	if c.Paradigms != nil {
+		options = append(options, WithParadigms(c.Paradigms))
+	}
+	if c.Inflections != nil {
+		options = append(options, WithInflections(c.Inflections))
+	}
+	if c.Tags != nil {
+		options = append(options, WithTags(c.Tags))
+	}
+	if c.Fuzzy > 0 {
+		options = append(options, WithFuzzy(c.Fuzzy))
+	}
+	if c.Derivations {
+		options = append(options, WithDerivations())
+	}
+	if c.Clitics {
+		options = append(options, WithClitics())
+	}
+	if c.Historical {
+		options = append(options, WithHistorical())
+	}
+	if c.Suffixes {
+		options = append(options, WithSuffixes())
+	}
+	if c.Compounds {
+		options = append(options, WithCompounds())
+	}
+	if c.Stemmer {
+		options = append(options, WithStemmer())
+	}
+	if c.Numbers != nil {
+		options = append(options, WithNumbers(*c.Numbers))
+	}
+	if c.Inclusive != nil {
+		options = append(options, WithInclusive(*c.Inclusive))
+	}
+	options = append(options,
+		WithCache(c.Cache),
+		WithDeadlinePerToken(c.DeadlinePerToken),
+		WithSingleCharacters(c.SingleCharacters),
+		WithFunctionWords(c.FunctionWords),
+	)
+	return New(c.Language, dictionary, append(options, opts...)...), nil
+}
+
+// diffDictionary returns the entries of dictionary that are not in base
+// or have another lemma there, and the forms of base missing from
+// dictionary. PoS sharing the map of base are skipped.
+func diffDictionary(base, dictionary map[POS]map[string]string) (map[POS]map[string]string, map[POS][]string) {
+	var overrides map[POS]map[string]string
+	var removed map[POS][]string
+	for pos, dict := range dictionary {
+		if sameMap(dict, base[pos]) {
+			continue
+		}
+		for form, lemma := range dict {
+			if baseLemma, ok := base[pos][form]; ok && baseLemma == lemma {
+				continue
+			}
+			if overrides == nil {
+				overrides = make(map[POS]map[string]string)
+			}
+			if overrides[pos] == nil {
+				overrides[pos] = make(map[string]string)
+			}
+			overrides[pos][form] = lemma
+		}
+	}
+	for pos, dict := range base {
+		if sameMap(dict, dictionary[pos]) {
+			continue
+		}
+		for form := range dict {
+			if _, ok := dictionary[pos][form]; ok {
+				continue
+			}
+			if removed == nil {
+				removed = make(map[POS][]string)
+			}
+			removed[pos] = append(removed[pos], form)
+		}
+	}
+	for _, forms := range removed {
+		sort.Strings(forms)
+	}
+	return overrides, removed
+}
+
+// applyOverrides returns base with the overrides and without the removed
+// forms, base itself when there are none
+func applyOverrides(base, overrides map[POS]map[string]string, removed map[POS][]string) map[POS]map[string]string {
+	if len(overrides) == 0 && len(removed) == 0 {
+		return base
+	}
+	dictionary := make(map[POS]map[string]string, len(base)+len(overrides))
+	for pos, dict := range base {
+		dictionary[pos] = dict
+	}
+	edited := func(pos POS) map[string]string {
+		dict := make(map[string]string, len(base[pos])+len(overrides[pos]))
+		for form, lemma := range base[pos] {
+			dict[form] = lemma
+		}
+		return dict
+	}
+	for pos, forms := range removed {
+		dict := edited(pos)
+		for _, form := range forms {
+			delete(dict, form)
+		}
+		dictionary[pos] = dict
+	}
+	for pos, entries := range overrides {
+		dict := dictionary[pos]
+		if _, ok := removed[pos]; !ok {
+			dict = edited(pos)
+		}
+		for form, lemma := range entries {
+			dict[form] = lemma
+		}
+		dictionary[pos] = dict
+	}
+	return dictionary
+}
+
+// sameMap reports whether a and b are the same map (not just equal ones),
+// nil maps being the same
+func sameMap(a, b interface{}) bool {
+	return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+}
@@ -0,0 +1,94 @@
+package lemmatizer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// contractionPart is a word of a contraction
+type contractionPart struct {
+	form  string
+	lemma string
+	pos   POS
+}
+
+// contractions are the words of the contractions expanded by
+// WithContractions, by language, as the UD treebanks split them
+var contractions = map[string]map[string][]contractionPart{
+	"es": {
+		"al":  {{"a", "a", ADP}, {"el", "el", DET}},
+		"del": {{"de", "de", ADP}, {"el", "el", DET}},
+	},
+	"fr": {
+		"au":  {{"à", "à", ADP}, {"le", "le", DET}},
+		"aux": {{"à", "à", ADP}, {"les", "le", DET}},
+		"du":  {{"de", "de", ADP}, {"le", "le", DET}},
+	},
+	"de": {
+		"am":   {{"an", "an", ADP}, {"dem", "der", DET}},
+		"beim": {{"bei", "bei", ADP}, {"dem", "der", DET}},
+		"im":   {{"in", "in", ADP}, {"dem", "der", DET}},
+		"ins":  {{"in", "in", ADP}, {"das", "der", DET}},
+		"vom":  {{"von", "von", ADP}, {"dem", "der", DET}},
+		"zum":  {{"zu", "zu", ADP}, {"dem", "der", DET}},
+		"zur":  {{"zu", "zu", ADP}, {"der", "der", DET}},
+	},
+}
+
+// WithContractions expands the contractions of the language into their
+// words ("del" is "de" and "el"), each with its own reading and the
+// contraction recorded in Token.Contraction. A capitalized contraction
+// followed by a capitalized word mid-sentence is taken for a name ("Al
+// Gore") and left alone.
+func WithContractions() PipelineOption {
+	return func(p *Pipeline) {
+		p.contractions = contractions[p.lemmatizer.lang]
+	}
+}
+
+// expansion is the contraction a token was expanded from
+type expansion struct {
+	surface string
+	index   int
+	part    contractionPart
+}
+
+// expand returns tokens with the contractions expanded, their offsets
+// and the expansion of each resulting token, nil for the tokens left as
+// is. The words of a contraction keep its offsets.
+func (p *Pipeline) expand(tokens []string, offsets []offset) ([]string, []offset, []*expansion) {
+	var expanded []string
+	var expandedOffsets []offset
+	var expansions []*expansion
+	for i, t := range tokens {
+		parts, ok := p.contractions[strings.ToLower(t)]
+		if ok && i > 0 && i+1 < len(tokens) && startsUpper(t) && startsUpper(tokens[i+1]) {
+			ok = false
+		}
+		if !ok {
+			expanded = append(expanded, t)
+			expandedOffsets = append(expandedOffsets, offsets[i])
+			expansions = append(expansions, nil)
+			continue
+		}
+		for j, part := range parts {
+			form := part.form
+			if t == strings.ToUpper(t) && utf8.RuneCountInString(t) > 1 {
+				form = strings.ToUpper(form)
+			} else if j == 0 && startsUpper(t) {
+				r, size := utf8.DecodeRuneInString(form)
+				form = string(unicode.ToUpper(r)) + form[size:]
+			}
+			expanded = append(expanded, form)
+			expandedOffsets = append(expandedOffsets, offsets[i])
+			expansions = append(expansions, &expansion{surface: t, index: j, part: part})
+		}
+	}
+	return expanded, expandedOffsets, expansions
+}
+
+func startsUpper(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsUpper(r)
+}
@@ -0,0 +1,135 @@
+// Code generated by dictgen; DO NOT EDIT.
+
+package de
+
+// Dictionary entries of PoS ADP, map of Form to Lemma
+var dictionaryADP = map[string]string{
+	"ab": "ab",
+	"abseits": "abseits",
+	"abzuglich": "abzüglich",
+	"abzüglich": "abzüglich",
+	"als": "als",
+	"am": "an+dem",
+	"an": "an",
+	"angesichts": "angesichts",
+	"anhand": "anhand",
+	"anlasslich": "anlässlich",
+	"anlässlich": "anlässlich",
+	"anläßlich": "anlässlich",
+	"ans": "an+das",
+	"anstatt": "anstatt",
+	"anstelle": "anstelle",
+	"auf": "auf",
+	"aufgrund": "aufgrund",
+	"aufs": "auf+das",
+	"aufseiten": "auf_seiten",
+	"aus": "aus",
+	"ausser": "ausser",
+	"ausserhalb": "ausserhalb",
+	"ausweislich": "ausweislich",
+	"außer": "ausser",
+	"außerhalb": "ausserhalb",
+	"bei": "bei",
+	"beim": "bei+dem",
+	"bezuglich": "bezüglich",
+	"bezüglich": "bezüglich",
+	"binnen": "binnen",
+	"bis": "bis",
+	"dank": "dank",
+	"diesseits": "diesseits",
+	"durch": "durch",
+	"durchs": "durch+das",
+	"einschliesslich": "einschliesslich",
+	"einschließlich": "einschliesslich",
+	"entgegen": "entgegen",
+	"entlang": "entlang",
+	"entsprechend": "entsprechend",
+	"fur": "für",
+	"furs": "für+das",
+	"für": "für",
+	"fürs": "für+das",
+	"gegen": "gegen",
+	"gegenuber": "gegenüber",
+	"gegenüber": "gegenüber",
+	"gemass": "gemäss",
+	"gemäss": "gemäss",
+	"gemäß": "gemäss",
+	"gen": "gen",
+	"halber": "halber",
+	"her": "her",
+	"hinsichtlich": "hinsichtlich",
+	"hinter": "hinter",
+	"hinterm": "hinter+dem",
+	"hinters": "hinter+das",
+	"im": "in+dem",
+	"in": "in",
+	"infolge": "infolge",
+	"inklusive": "inklusive",
+	"inmitten": "inmitten",
+	"innerhalb": "innerhalb",
+	"ins": "in+das",
+	"jenseits": "jenseits",
+	"kontra": "kontra",
+	"kraft": "kraft",
+	"langs": "längs",
+	"laut": "laut",
+	"längs": "längs",
+	"mangels": "mangels",
+	"minus": "minus",
+	"mit": "mit",
+	"mitsamt": "mitsamt",
+	"mittels": "mittels",
+	"nach": "nach",
+	"nachst": "nächst",
+	"nahe": "nahe",
+	"namens": "namens",
+	"neben": "neben",
+	"nebst": "nebst",
+	"nächst": "nächst",
+	"ob": "ob",
+	"oberhalb": "oberhalb",
+	"ohne": "ohne",
+	"per": "per",
+	"plus": "plus",
+	"pro": "pro",
+	"samt": "samt",
+	"seit": "seit",
+	"seitens": "seitens",
+	"statt": "statt",
+	"trotz": "trotz",
+	"uber": "über",
+	"uberm": "über+dem",
+	"ubers": "über+das",
+	"um": "um",
+	"ums": "um+das",
+	"ungeachtet": "ungeachtet",
+	"unter": "unter",
+	"unterhalb": "unterhalb",
+	"unterm": "unter+dem",
+	"unters": "unter+das",
+	"unweit": "unweit",
+	"versus": "versus",
+	"via": "via",
+	"vom": "von+dem",
+	"von": "von",
+	"vor": "vor",
+	"vorm": "vor+dem",
+	"vors": "vor+das",
+	"wahrend": "während",
+	"wegen": "wegen",
+	"wider": "wider",
+	"willen": "willen",
+	"während": "während",
+	"zu": "zu",
+	"zugunsten": "zugunsten",
+	"zuliebe": "zuliebe",
+	"zum": "zu+dem",
+	"zur": "zu+der",
+	"zuzuglich": "zuzüglich",
+	"zuzüglich": "zuzüglich",
+	"zwecks": "zwecks",
+	"zwischen": "zwischen",
+	"über": "über",
+	"überm": "über+dem",
+	"übers": "über+das",
+}
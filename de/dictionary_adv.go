@@ -0,0 +1,1097 @@
+// Code generated by dictgen; DO NOT EDIT.
+
+package de
+
+// Dictionary entries of PoS ADV, map of Form to Lemma
+var dictionaryADV = map[string]string{
+	"ab": "ab",
+	"abend": "abend",
+	"abends": "abends",
+	"aber": "aber",
+	"abermals": "abermals",
+	"abschliessend": "abschliessend",
+	"abschließend": "abschliessend",
+	"abseits": "abseits",
+	"absolut": "absolut",
+	"abwarts": "abwärts",
+	"abwärts": "abwärts",
+	"acht": "acht",
+	"achtmal": "achtmal",
+	"alias": "alias",
+	"allabendlich": "allabendlich",
+	"alle": "alle",
+	"allein": "allein",
+	"alleine": "alleine",
+	"allemal": "allemal",
+	"allenfalls": "allenfalls",
+	"allenthalben": "allenthalben",
+	"allenthalber": "allenthalber",
+	"allerdings": "allerdings",
+	"allerhand": "allerhand",
+	"allerlei": "allerlei",
+	"allerorts": "allerorts",
+	"allesamt": "allesamt",
+	"allhier": "allhier",
+	"allseits": "allseits",
+	"allzeit": "allzeit",
+	"allzu": "allzu",
+	"allzusehr": "allzusehr",
+	"alsbald": "alsbald",
+	"also": "also",
+	"alters": "alters",
+	"amtlicherseits": "amtlicherseits",
+	"an": "an",
+	"andererseits": "andererseits",
+	"andernfalls": "andernfalls",
+	"andernorts": "andernorts",
+	"anderntags": "anderntags",
+	"anders": "anders",
+	"anderswo": "anderswo",
+	"anderswohin": "anderswohin",
+	"aneinander": "aneinander",
+	"anfangs": "anfangs",
+	"angst": "angst",
+	"annahernd": "annähernd",
+	"anno": "anno",
+	"annähernd": "annähernd",
+	"ansatzweise": "ansatzweise",
+	"anscheinend": "anscheinend",
+	"anschliessend": "anschliessend",
+	"anschließend": "anschliessend",
+	"ansonsten": "ansonsten",
+	"anstandshalber": "anstandshalber",
+	"apropos": "apropos",
+	"arg": "arg",
+	"arztlicherseits": "ärztlicherseits",
+	"auch": "auch",
+	"auf": "auf",
+	"aufeinander": "aufeinander",
+	"aufrecht": "aufrecht",
+	"aufwarts": "aufwärts",
+	"aufwärts": "aufwärts",
+	"aus": "aus",
+	"auseinander": "auseinander",
+	"ausfindig": "ausfindig",
+	"ausgerechnet": "ausgerechnet",
+	"ausnahmsweise": "ausnahmsweise",
+	"ausschliesslich": "ausschliesslich",
+	"ausschließlich": "ausschliesslich",
+	"aussen": "aussen",
+	"ausser": "ausser",
+	"ausserdem": "ausserdem",
+	"ausserhalb": "ausserhalb",
+	"ausserst": "äusserst",
+	"ausserstande": "ausserstande",
+	"außen": "aussen",
+	"außer": "ausser",
+	"außerdem": "ausserdem",
+	"außerhalb": "ausserhalb",
+	"außerstande": "ausserstande",
+	"bald": "bald",
+	"baldmoglichst": "baldmöglichst",
+	"baldmöglichst": "baldmöglichst",
+	"ball": "ball",
+	"bange": "bange",
+	"barfuss": "barfuss",
+	"barfuß": "barfuss",
+	"bedauerlicherweise": "bedauerlicherweise",
+	"befehlsgemass": "befehlsgemäss",
+	"befehlsgemäss": "befehlsgemäss",
+	"befehlsgemäß": "befehlsgemäss",
+	"befristet": "befristet",
+	"begreiflicherweise": "begreiflicherweise",
+	"bei": "bei",
+	"beiderseits": "beiderseits",
+	"beieinander": "beieinander",
+	"beinahe": "beinahe",
+	"beisammen": "beisammen",
+	"beiseite": "beiseite",
+	"beispielsweise": "beispielsweise",
+	"bekannt": "bekannt",
+	"bekanntermassen": "bekanntermassen",
+	"bekanntermaßen": "bekanntermassen",
+	"bekanntlich": "bekanntlich",
+	"bereit": "bereit",
+	"bereits": "bereits",
+	"bergab": "bergab",
+	"bergauf": "bergauf",
+	"bescheid": "bescheid",
+	"besonders": "besonders",
+	"bestehen": "bestehen",
+	"bestenfalls": "bestenfalls",
+	"bestens": "bestens",
+	"bestimmt": "bestimmt",
+	"bevor": "bevor",
+	"bezeichnenderweise": "bezeichnenderweise",
+	"beziehungsweise": "beziehungsweise",
+	"bis": "bis",
+	"bisher": "bisher",
+	"bislag": "bislang",
+	"bislang": "bislang",
+	"bisweilen": "bisweilen",
+	"bitte": "bitte",
+	"bitteschon": "bitteschön",
+	"bitteschön": "bitteschön",
+	"bittschon": "bittschön",
+	"bittschön": "bittschön",
+	"blank": "blank",
+	"bleiben": "bleiben",
+	"blind": "blind",
+	"bloss": "bloss",
+	"bloß": "bloss",
+	"brach": "brach",
+	"breit": "breit",
+	"brutto": "brutto",
+	"cash": "cash",
+	"circa": "circa",
+	"da": "da",
+	"dabei": "dabei",
+	"dadurch": "dadurch",
+	"dafur": "dafür",
+	"dafür": "dafür",
+	"dagegen": "dagegen",
+	"daheim": "daheim",
+	"daher": "daher",
+	"dahin": "dahin",
+	"dahinter": "dahinter",
+	"damals": "damals",
+	"damit": "damit",
+	"danach": "danach",
+	"daneben": "daneben",
+	"danke": "danke",
+	"dann": "dann",
+	"dar": "dar",
+	"daran": "daran",
+	"darauf": "darauf",
+	"daraufhin": "daraufhin",
+	"daraus": "daraus",
+	"darein": "darein",
+	"darin": "darin",
+	"darob": "darob",
+	"daruber": "darüber",
+	"daruberhinaus": "darüberhinaus",
+	"darum": "darum",
+	"darunter": "darunter",
+	"darüber": "darüber",
+	"darüberhinaus": "darüberhinaus",
+	"dato": "dato",
+	"davon": "davon",
+	"davor": "davor",
+	"dazu": "dazu",
+	"dazwischen": "dazwischen",
+	"de": "de",
+	"demgegenuber": "demgegenüber",
+	"demgegenüber": "demgegenüber",
+	"demnach": "demnach",
+	"demnachst": "demnächst",
+	"demnächst": "demnächst",
+	"demzufolge": "demzufolge",
+	"denn": "denn",
+	"dennoch": "dennoch",
+	"derart": "derart",
+	"dereinst": "dereinst",
+	"dergestalt": "dergestalt",
+	"dermassen": "dermassen",
+	"dermaßen": "dermassen",
+	"derweil": "derweil",
+	"derzeit": "derzeit",
+	"desgleichen": "desgleichen",
+	"deshalb": "deshalb",
+	"dessenungeachtet": "dessenungeachtet",
+	"desto": "desto",
+	"deswegen": "deswegen",
+	"deutlich": "deutlich",
+	"dicht": "dicht",
+	"dienstags": "dienstags",
+	"diesbezuglich": "diesbezüglich",
+	"diesbezüglich": "diesbezüglich",
+	"diesmal": "diesmal",
+	"doch": "doch",
+	"donnerstags": "donnerstags",
+	"dort": "dort",
+	"dorther": "dorther",
+	"dorthin": "dorthin",
+	"dran": "dran",
+	"drauf": "drauf",
+	"draus": "draus",
+	"draussen": "draussen",
+	"draußen": "draussen",
+	"dreimal": "dreimal",
+	"drin": "drin",
+	"drinnen": "drinnen",
+	"drittenmal": "drittenmal",
+	"drittens": "drittens",
+	"droben": "droben",
+	"druben": "drüben",
+	"druber": "drüber",
+	"drunten": "drunten",
+	"drunter": "drunter",
+	"drüben": "drüben",
+	"drüber": "drüber",
+	"duchaus": "duchaus",
+	"durch": "durch",
+	"durchaus": "durchaus",
+	"durcheinander": "durcheinander",
+	"durchweg": "durchweg",
+	"eben": "eben",
+	"ebenfalls": "ebenfalls",
+	"ebenso": "ebenso",
+	"ebensowenig": "ebensowenig",
+	"egal": "egal",
+	"eh": "eh",
+	"ehedem": "ehedem",
+	"ehemals": "ehemals",
+	"eher": "eher",
+	"ehrlicherweise": "ehrlicherweise",
+	"eigens": "eigens",
+	"eigentlich": "eigentlich",
+	"eilends": "eilends",
+	"ein": "ein",
+	"einander": "einander",
+	"einerseits": "einerseits",
+	"einfach": "einfach",
+	"eingangs": "eingangs",
+	"einher": "einher",
+	"einigermassen": "einigermassen",
+	"einigermaßen": "einigermassen",
+	"einmal": "einmal",
+	"einschliesslich": "einschliesslich",
+	"einschließlich": "einschliesslich",
+	"einst": "einst",
+	"einstmals": "einstmals",
+	"einstweilen": "einstweilen",
+	"einzig": "einzig",
+	"endlich": "endlich",
+	"eng": "eng",
+	"entgegen": "entgegen",
+	"entlang": "entlang",
+	"entweder": "entweder",
+	"entzwei": "entzwei",
+	"erfreulicherweise": "erfreulicherweise",
+	"ergo": "ergo",
+	"erneut": "erneut",
+	"ernst": "ernst",
+	"erst": "erst",
+	"erstaunlicherweise": "erstaunlicherweise",
+	"erstenmal": "erstenmal",
+	"erstens": "erstens",
+	"erstmal": "erstmal",
+	"erstmals": "erstmals",
+	"erwartungsgemass": "erwartungsgemäss",
+	"erwartungsgemäss": "erwartungsgemäss",
+	"erwartungsgemäß": "erwartungsgemäss",
+	"etc": "etc",
+	"etwa": "etwa",
+	"etwas": "etwas",
+	"exclusive": "exclusive",
+	"extra": "extra",
+	"facto": "facto",
+	"falschlicherweise": "fälschlicherweise",
+	"fast": "fast",
+	"fehl": "fehl",
+	"feiertags": "feiertags",
+	"feil": "feil",
+	"fein": "fein",
+	"fern": "fern",
+	"ferne": "ferne",
+	"ferner": "fern",
+	"fertig": "fertig",
+	"fest": "fest",
+	"flach": "flach",
+	"floten": "flöten",
+	"flott": "flott",
+	"flugs": "flugs",
+	"flussabwarts": "flussabwärts",
+	"flussabwärts": "flussabwärts",
+	"flussaufwarts": "flussaufwärts",
+	"flussaufwärts": "flussaufwärts",
+	"flußabwärts": "flussabwärts",
+	"flußaufwärts": "flussaufwärts",
+	"flöten": "flöten",
+	"folgendermassen": "folgendermassen",
+	"folgendermaßen": "folgendermassen",
+	"folglich": "folglich",
+	"fort": "fort",
+	"fortan": "fortan",
+	"frei": "frei",
+	"freiheraus": "freiheraus",
+	"freilich": "freilich",
+	"freitags": "freitags",
+	"fremd": "fremd",
+	"fruher": "früh",
+	"fruhestens": "frühestens",
+	"fruhmorgens": "frühmorgens",
+	"früher": "früh",
+	"frühestens": "frühestens",
+	"frühmorgens": "frühmorgens",
+	"funfmal": "fünfmal",
+	"funftens": "fünftens",
+	"fureinander": "füreinander",
+	"furwahr": "fürwahr",
+	"fälschlicherweise": "fälschlicherweise",
+	"fünfmal": "fünfmal",
+	"fünftens": "fünftens",
+	"füreinander": "füreinander",
+	"fürwahr": "fürwahr",
+	"gangigerweise": "gängigerweise",
+	"ganz": "ganz",
+	"gar": "gar",
+	"garnicht": "garnicht",
+	"gefalligst": "gefälligst",
+	"gefangen": "gefangen",
+	"gefälligst": "gefälligst",
+	"gegebenenfalls": "gegebenenfalls",
+	"gegen": "gegen",
+	"gegeneinander": "gegeneinander",
+	"gegenuber": "gegenüber",
+	"gegenüber": "gegenüber",
+	"geheim": "geheim",
+	"gelegen": "gelegen",
+	"gemeinhin": "gemeinhin",
+	"genau": "genau",
+	"genauso": "genauso",
+	"genausogut": "genausogut",
+	"genausowenig": "genausowenig",
+	"genausowie": "genausowie",
+	"genug": "genug",
+	"gerade": "gerade",
+	"gerademal": "gerademal",
+	"geradeso": "geradeso",
+	"geradewegs": "geradewegs",
+	"geradezu": "geradezu",
+	"gerechterenfalls": "gerechterenfalls",
+	"gering": "gering",
+	"gern": "gern",
+	"gerne": "gerne",
+	"geschweige": "geschweige",
+	"gestern": "gestern",
+	"gesund": "gesund",
+	"gewiss": "gewiss",
+	"gewissermassen": "gewissermassen",
+	"gewissermaßen": "gewissermassen",
+	"gewiß": "gewiss",
+	"glatt": "glatt",
+	"gleich": "gleich",
+	"gleichauf": "gleichauf",
+	"gleichermassen": "gleichermassen",
+	"gleichermaßen": "gleichermassen",
+	"gleicherweise": "gleicherweise",
+	"gleichfalls": "gleichfalls",
+	"gleichmassen": "gleichmassen",
+	"gleichmaßen": "gleichmassen",
+	"gleichoft": "gleichoft",
+	"gleichsam": "gleichsam",
+	"gleichwohl": "gleichwohl",
+	"gleichzeitig": "gleichzeitig",
+	"glucklicherweise": "glücklicherweise",
+	"glücklicherweise": "glücklicherweise",
+	"gottlob": "gottlob",
+	"gratis": "gratis",
+	"grosstenteils": "grösstenteils",
+	"grundsatzlich": "grundsätzlich",
+	"grundsätzlich": "grundsätzlich",
+	"gruppenweit": "gruppenweit",
+	"grösstenteils": "grösstenteils",
+	"größtenteils": "grösstenteils",
+	"gut": "gut",
+	"gängigerweise": "gängigerweise",
+	"haften": "haften",
+	"halbtags": "halbtags",
+	"halbwegs": "halbwegs",
+	"halt": "halt",
+	"hangen": "hängen",
+	"hart": "hart",
+	"hauptsachlich": "hauptsächlich",
+	"hauptsächlich": "hauptsächlich",
+	"heidenangst": "heidenangst",
+	"heilig": "heilig",
+	"heim": "heim",
+	"her": "her",
+	"herab": "herab",
+	"heran": "heran",
+	"herauf": "herauf",
+	"heraus": "heraus",
+	"herbei": "herbei",
+	"herein": "herein",
+	"herum": "herum",
+	"herunter": "herunter",
+	"hervor": "hervor",
+	"heuer": "heuer",
+	"heute": "heute",
+	"heutzutage": "heutzutage",
+	"hie": "hie",
+	"hier": "hier",
+	"hieran": "hieran",
+	"hierauf": "hierauf",
+	"hieraus": "hieraus",
+	"hierbei": "hierbei",
+	"hierdurch": "hierdurch",
+	"hierfur": "hierfür",
+	"hierfür": "hierfür",
+	"hiergegen": "hiergegen",
+	"hierher": "hierher",
+	"hierhin": "hierhin",
+	"hierin": "hierin",
+	"hiermit": "hiermit",
+	"hiernach": "hiernach",
+	"hieruber": "hierüber",
+	"hiervon": "hiervon",
+	"hiervor": "hiervor",
+	"hierzu": "hierzu",
+	"hierzulande": "hierzulande",
+	"hierüber": "hierüber",
+	"hin": "hin",
+	"hinab": "hinab",
+	"hinauf": "hinauf",
+	"hinaus": "hinaus",
+	"hindurch": "hindurch",
+	"hinein": "hinein",
+	"hinfort": "hinfort",
+	"hingegen": "hingegen",
+	"hinten": "hinten",
+	"hintenraus": "hintenraus",
+	"hinter": "hinter",
+	"hintereinander": "hintereinander",
+	"hinterher": "hinterher",
+	"hinuber": "hinüber",
+	"hinunter": "hinunter",
+	"hinweg": "hinweg",
+	"hinzu": "hinzu",
+	"hinüber": "hinüber",
+	"hoch": "hoch",
+	"hochst": "höchst",
+	"hochstens": "höchstens",
+	"hochstpersonlich": "höchstpersönlich",
+	"hoffentlich": "hoffentlich",
+	"hängen": "hängen",
+	"höchst": "höchst",
+	"höchstens": "höchstens",
+	"höchstpersönlich": "höchstpersönlich",
+	"ihrerseits": "ihrerseits",
+	"ihretwegen": "ihretwegen",
+	"immens": "immens",
+	"immer": "immer",
+	"immerhin": "immerhin",
+	"immerzu": "immerzu",
+	"imstande": "imstande",
+	"in": "in",
+	"inbesondere": "inbesondere",
+	"indes": "indes",
+	"indessen": "indessen",
+	"ineinander": "ineinander",
+	"infolgedessen": "infolgedessen",
+	"inklusive": "inklusive",
+	"inmmer": "inmmer",
+	"inne": "inne",
+	"innen": "innen",
+	"insbesondere": "insbesondere",
+	"insgeheim": "insgeheim",
+	"insgesamt": "insgesamt",
+	"insofern": "insofern",
+	"insoweit": "insoweit",
+	"interessanterweise": "interessanterweise",
+	"inwieweit": "inwieweit",
+	"inzwischen": "inzwischen",
+	"irgend": "irgend",
+	"irgendwann": "irgendwann",
+	"irgendwie": "irgendwie",
+	"irgendwo": "irgendwo",
+	"irgendwoher": "irgendwoher",
+	"irgendwohin": "irgendwohin",
+	"irre": "irre",
+	"ja": "ja",
+	"jahraus": "jahraus",
+	"jahrein": "jahrein",
+	"jahrzehntelang": "jahrzehntelang",
+	"je": "je",
+	"jedenfalls": "jedenfalls",
+	"jederart": "jederart",
+	"jederzeit": "jederzeit",
+	"jedesmal": "jedesmal",
+	"jedoch": "jedoch",
+	"jeher": "jeh",
+	"jemals": "jemals",
+	"jenseits": "jenseits",
+	"jetzt": "jetzt",
+	"jeweils": "jeweils",
+	"jungst": "jüngst",
+	"just": "just",
+	"jüngst": "jüngst",
+	"kahl": "kahl",
+	"kalt": "kalt",
+	"kaputt": "kaputt",
+	"kaum": "kaum",
+	"keinerlei": "keinerlei",
+	"keinesfalls": "keinesfalls",
+	"keineswegs": "keineswegs",
+	"kennen": "kennen",
+	"klar": "klar",
+	"kleben": "kleben",
+	"klein": "klein",
+	"knapp": "knapp",
+	"konsequenterweise": "konsequenterweise",
+	"krank": "krank",
+	"kreuz": "kreuz",
+	"kund": "kund",
+	"kunftig": "künftig",
+	"kurioserweise": "kurioserweise",
+	"kurz": "kurz",
+	"kurzerhand": "kurzerhand",
+	"kurzlich": "kürzlich",
+	"kurzum": "kurzum",
+	"künftig": "künftig",
+	"kürzlich": "kürzlich",
+	"lahm": "lahm",
+	"lang": "lang",
+	"lange": "lang",
+	"langsseits": "längsseits",
+	"langst": "längst",
+	"langstens": "längstens",
+	"lauthals": "lauthals",
+	"leck": "leck",
+	"lediglich": "lediglich",
+	"leer": "leer",
+	"leicht": "leicht",
+	"leichthin": "leichthin",
+	"leider": "leider",
+	"letztendlich": "letztendlich",
+	"letztens": "letztens",
+	"letztlich": "letztlich",
+	"letztmals": "letztmals",
+	"lieb": "lieb",
+	"lieber": "lieber",
+	"liegen": "liegen",
+	"light": "light",
+	"links": "links",
+	"live": "live",
+	"locker": "locker",
+	"logischerweise": "logischerweise",
+	"los": "los",
+	"längsseits": "längsseits",
+	"längst": "längst",
+	"längstens": "längstens",
+	"mal": "mal",
+	"mancherorts": "mancherorts",
+	"manchmal": "manchmal",
+	"marsch": "marsch",
+	"mass": "mass",
+	"massenweise": "massenweise",
+	"matt": "matt",
+	"maximal": "maximal",
+	"maß": "mass",
+	"medium": "medium",
+	"mehr": "mehr",
+	"mehrfach": "mehrfach",
+	"mehrmals": "mehrmals",
+	"meinetwegen": "meinetwegen",
+	"meist": "meist",
+	"meistens": "meistens",
+	"minder": "minder",
+	"mindestens": "mindestens",
+	"minus": "minus",
+	"mit": "mit",
+	"miteinander": "miteinander",
+	"mithin": "mithin",
+	"mitnichten": "mitnichten",
+	"mittag": "mittag",
+	"mittags": "mittags",
+	"mitten": "mitten",
+	"mittendrin": "mittendrin",
+	"mittlerweile": "mittlerweile",
+	"mittlerweise": "mittlerweile",
+	"mittwochs": "mittwochs",
+	"mitunter": "mitunter",
+	"moglicherweise": "möglicherweise",
+	"moglichst": "möglichst",
+	"monatelang": "monatelang",
+	"montags": "montags",
+	"morgen": "morgen",
+	"morgens": "morgens",
+	"möglicherweise": "möglicherweise",
+	"möglichst": "möglichst",
+	"nach": "nach",
+	"nachdem": "nachdem",
+	"nacheinander": "nacheinander",
+	"nachgewiesenerweise": "nachgewiesenerweise",
+	"nachher": "nachher",
+	"nachhinein": "nachhinein",
+	"nachmals": "nachmals",
+	"nachmittag": "nachmittag",
+	"nachmittags": "nachmittags",
+	"nachstens": "nächstens",
+	"nacht": "nacht",
+	"nachts": "nachts",
+	"nahe": "nahe",
+	"nahebei": "nahebei",
+	"naher": "näher",
+	"nahezu": "nahezu",
+	"namens": "namens",
+	"namentlich": "namentlich",
+	"namlich": "nämlich",
+	"naturlich": "natürlich",
+	"natürlich": "natürlich",
+	"nebenan": "nebenan",
+	"nebenbei": "nebenbei",
+	"nebeneinander": "nebeneinander",
+	"nebenher": "nebenher",
+	"nein": "nein",
+	"netto": "netto",
+	"neu": "neu",
+	"neuerdings": "neuerdings",
+	"neulich": "neulich",
+	"nicht": "nicht",
+	"nichtsdestotrotz": "nichtsdestotrotz",
+	"nichtsdestoweniger": "nichtsdestoweniger",
+	"nie": "nie",
+	"nieder": "nieder",
+	"niemals": "niemals",
+	"nimmer": "nimmer",
+	"nimmermehr": "nimmermehr",
+	"nirgends": "nirgends",
+	"nirgendwo": "nirgendwo",
+	"nirgenswo": "nirgenswo",
+	"noch": "noch",
+	"nochmal": "nochmal",
+	"nochmals": "nochmals",
+	"normalerweise": "normalerweise",
+	"not": "not",
+	"notfalls": "notfalls",
+	"notwendigerweise": "notwendigerweise",
+	"nun": "nun",
+	"nunmehr": "nunmehr",
+	"nur": "nur",
+	"nächstens": "nächstens",
+	"näher": "näher",
+	"nämlich": "nämlich",
+	"oben": "oben",
+	"obenan": "obenan",
+	"obenauf": "obenauf",
+	"obendrein": "obendrein",
+	"offen": "offen",
+	"offenbar": "offenbar",
+	"offensichtlich": "offensichtlich",
+	"offentlich": "öffentlich",
+	"oft": "oft",
+	"ofter": "öfter",
+	"ofters": "öfters",
+	"oftmals": "oftmals",
+	"ohnedies": "ohnedies",
+	"ohnegleichen": "ohnegleichen",
+	"ohnehin": "ohnehin",
+	"ohnhin": "ohnhin",
+	"online": "online",
+	"ost": "ost",
+	"out": "out",
+	"paradoxerweise": "paradoxerweise",
+	"parat": "parat",
+	"partout": "partout",
+	"personlich": "persönlich",
+	"persönlich": "persönlich",
+	"phasenweise": "phasenweise",
+	"platt": "platt",
+	"plausiblerweise": "plausiblerweise",
+	"pleite": "pleite",
+	"plotzlich": "plötzlich",
+	"plus": "plus",
+	"plötzlich": "plötzlich",
+	"preis": "preis",
+	"prima": "prima",
+	"probeweise": "probeweise",
+	"puncto": "puncto",
+	"punkto": "punkto",
+	"quartalsweise": "quartalsweise",
+	"quasi": "quasi",
+	"quer": "quer",
+	"rad": "rad",
+	"rar": "rar",
+	"rauf": "rauf",
+	"raus": "raus",
+	"realistischerweise": "realistischerweise",
+	"realiter": "realiter",
+	"recht": "recht",
+	"rechtens": "rechtens",
+	"rechts": "rechts",
+	"rechtzeitig": "rechtzeitig",
+	"reihenweise": "reihenweise",
+	"rein": "rein",
+	"retour": "retour",
+	"richtig": "richtig",
+	"ringsum": "ringsum",
+	"rosa": "rosa",
+	"rose": "rose",
+	"ruber": "rüber",
+	"ruckwarts": "rückwärts",
+	"ruhig": "ruhig",
+	"rum": "rum",
+	"rund": "rund",
+	"rundherum": "rundherum",
+	"rundum": "rundum",
+	"rundweg": "rundweg",
+	"runter": "runter",
+	"rüber": "rüber",
+	"rückwärts": "rückwärts",
+	"samstags": "samstags",
+	"sauber": "sauber",
+	"schade": "schade",
+	"scharf": "scharf",
+	"schariagemass": "schariagemäss",
+	"schariagemäss": "schariagemäss",
+	"schariagemäß": "schariagemäss",
+	"schatzen": "schätzen",
+	"schatzungsweise": "schätzungsweise",
+	"schlankweg": "schlankweg",
+	"schlechthin": "schlechthin",
+	"schlichtweg": "schlichtweg",
+	"schliesslich": "schliesslich",
+	"schließlich": "schliesslich",
+	"schlimmstenfalls": "schlimmstenfalls",
+	"schnellstens": "schnellstens",
+	"schnurstracks": "schnurstracks",
+	"schon": "schon",
+	"schuld": "schuld",
+	"schwarz": "schwarz",
+	"schwer": "schwer",
+	"schätzen": "schätzen",
+	"schätzungsweise": "schätzungsweise",
+	"schön": "schön",
+	"sechsmal": "sechsmal",
+	"sechsmillionenmal": "sechsmillionenmal",
+	"sehnlichst": "sehnlichst",
+	"sehr": "sehr",
+	"seinerseits": "seinerseits",
+	"seinerzeit": "seinerzeit",
+	"seinetwegen": "seinetwegen",
+	"seitdem": "seitdem",
+	"seither": "seither",
+	"seitwarts": "seitwärts",
+	"seitwärts": "seitwärts",
+	"selber": "selber",
+	"selbst": "selbst",
+	"selbstredend": "selbstredend",
+	"selbstverstandlich": "selbstverständlich",
+	"selbstverständlich": "selbstverständlich",
+	"selten": "selten",
+	"sicher": "sicher",
+	"sicherheitshalber": "sicherheitshalber",
+	"sicherlich": "sicherlich",
+	"sinngemass": "sinngemäss",
+	"sinngemäss": "sinngemäss",
+	"sinngemäß": "sinngemäss",
+	"so": "so",
+	"sobald": "sobald",
+	"sodann": "sodann",
+	"soeben": "soeben",
+	"sofort": "sofort",
+	"sogar": "sogar",
+	"sogleich": "sogleich",
+	"solange": "solange",
+	"solch": "solch",
+	"solcherart": "solcherart",
+	"solchermassen": "solchermassen",
+	"solchermaßen": "solchermassen",
+	"somit": "somit",
+	"sondergleichen": "sondergleichen",
+	"sonnabends": "sonnabends",
+	"sonntags": "sonntags",
+	"sonst": "sonst",
+	"soviel": "soviel",
+	"soweit": "soweit",
+	"sowenig": "sowenig",
+	"sowieso": "sowieso",
+	"sozusagen": "sozusagen",
+	"spater": "spät",
+	"spatestens": "spätestens",
+	"spazieren": "spazieren",
+	"spitz": "spitz",
+	"sprich": "sprich",
+	"später": "spät",
+	"spätestens": "spätestens",
+	"stand": "stand",
+	"stark": "stark",
+	"statt": "statt",
+	"stattdessen": "stattdessen",
+	"staub": "staub",
+	"stehen": "stehen",
+	"stellenweise": "stellenweise",
+	"stets": "stets",
+	"still": "still",
+	"stramm": "stramm",
+	"streitig": "streitig",
+	"stromabwarts": "stromabwärts",
+	"stromabwärts": "stromabwärts",
+	"stromaufwarts": "stromaufwärts",
+	"stromaufwärts": "stromaufwärts",
+	"stundenweise": "stundenweise",
+	"tabu": "tabu",
+	"tags": "tags",
+	"tagsuber": "tagsüber",
+	"tagsüber": "tagsüber",
+	"tatsachlich": "tatsächlich",
+	"tatsächlich": "tatsächlich",
+	"teil": "teil",
+	"teils": "teils",
+	"teilweise": "teilweise",
+	"testweise": "testweise",
+	"toi": "toi",
+	"tonnenweise": "tonnenweise",
+	"tot": "tot",
+	"triotzdem": "trotzdem",
+	"trotzdem": "trotzdem",
+	"tunlichst": "tunlichst",
+	"typischerweise": "typischerweise",
+	"ubel": "übel",
+	"uber": "über",
+	"uberahl": "überall",
+	"uberall": "überall",
+	"uberallher": "überallher",
+	"uberallhin": "überallhin",
+	"uberaus": "überaus",
+	"uberdies": "überdies",
+	"uberein": "überein",
+	"ubereinander": "übereinander",
+	"uberhand": "überhand",
+	"uberhaupt": "überhaupt",
+	"ubermorgen": "übermorgen",
+	"uberraschenderweise": "überraschenderweise",
+	"ublicherweise": "üblicherweise",
+	"ubrig": "übrig",
+	"ubrigens": "übrigens",
+	"um": "um",
+	"umher": "umher",
+	"umhin": "umhin",
+	"umso": "umso",
+	"umsonst": "umsonst",
+	"unbedingt": "unbedingt",
+	"uneins": "uneins",
+	"ungefahr": "ungefähr",
+	"ungefähr": "ungefähr",
+	"ungemein": "ungemein",
+	"ungerechterweise": "ungerechterweise",
+	"ungern": "ungern",
+	"unglucklicherweise": "unglücklicherweise",
+	"unglücklicherweise": "unglücklicherweise",
+	"unisono": "unisono",
+	"unlangst": "unlängst",
+	"unlängst": "unlängst",
+	"unnotigerweise": "unnötigerweise",
+	"unnötigerweise": "unnötigerweise",
+	"unten": "unten",
+	"unter": "unter",
+	"unterdessen": "unterdessen",
+	"untereinander": "untereinander",
+	"unterschiedslos": "unterschiedslos",
+	"unterwegs": "unterwegs",
+	"unverandert": "unverändert",
+	"unverdientermassen": "unverdientermassen",
+	"unverdientermaßen": "unverdientermassen",
+	"unverhaltnismassig": "unverhältnismässig",
+	"unverhältnismässig": "unverhältnismässig",
+	"unverhältnismäßig": "unverhältnismässig",
+	"unverschamterweise": "unverschämterweise",
+	"unverschämterweise": "unverschämterweise",
+	"unversehens": "unversehens",
+	"unverändert": "unverändert",
+	"unwiderruflich": "unwiderruflich",
+	"ursachlich": "ursächlich",
+	"ursächlich": "ursächlich",
+	"verantwortlich": "verantwortlich",
+	"vergebens": "vergebens",
+	"vergleichsweise": "vergleichsweise",
+	"verloren": "verloren",
+	"vermutlich": "vermutlich",
+	"verstandlicherweise": "verständlicherweise",
+	"verständlicherweise": "verständlicherweise",
+	"vertrackterweise": "vertrackterweise",
+	"viel": "viel",
+	"vielerorts": "vielerorts",
+	"vielfach": "vielfach",
+	"vielleicht": "vielleicht",
+	"vielmehr": "vielmehr",
+	"viermal": "viermal",
+	"viertens": "viertens",
+	"voll": "voll",
+	"vollends": "vollends",
+	"vollig": "völlig",
+	"voneinander": "voneinander",
+	"vonnoten": "vonnöten",
+	"vonnöten": "vonnöten",
+	"vonstatten": "vonstatten",
+	"vor": "vor",
+	"vorab": "vorab",
+	"voran": "voran",
+	"voraus": "voraus",
+	"voraussichtlich": "voraussichtlich",
+	"vorbei": "vorbei",
+	"vordem": "vordem",
+	"vorerst": "vorerst",
+	"vorgestern": "vorgestern",
+	"vorher": "vorher",
+	"vorhin": "vorhin",
+	"vormals": "vormals",
+	"vormittag": "vormittag",
+	"vorn": "vorn",
+	"vorne": "vorne",
+	"vornehmlich": "vornehmlich",
+	"vorneweg": "vorneweg",
+	"vornherein": "vornherein",
+	"vorsichtshalber": "vorsichtshalber",
+	"voruber": "vorüber",
+	"vorwarts": "vorwärts",
+	"vorweg": "vorweg",
+	"vorwärts": "vorwärts",
+	"vorzeitig": "vorzeitig",
+	"vorüber": "vorüber",
+	"völlig": "völlig",
+	"wahlweise": "wahlweise",
+	"wahr": "wahr",
+	"wahrenddessen": "währenddessen",
+	"wahrhaft": "wahrhaft",
+	"wahrlich": "wahrlich",
+	"wahrscheinlich": "wahrscheinlich",
+	"wann": "wann",
+	"warm": "warm",
+	"warum": "warum",
+	"wech": "wech",
+	"weg": "weg",
+	"weh": "weh",
+	"weiland": "weiland",
+	"weit": "weit",
+	"weitab": "weitab",
+	"weitaus": "weitaus",
+	"weiter": "weiter",
+	"weiterhin": "weiterhin",
+	"weitgehend": "weitgehend",
+	"weithin": "weithin",
+	"wenig": "wenig",
+	"weniger": "weniger",
+	"wenigsten": "wenigstens",
+	"wenigstens": "wenigstens",
+	"wenn": "wenn",
+	"werktags": "werktags",
+	"wert": "wert",
+	"wesentlich": "wesentlich",
+	"weshalb": "weshalb",
+	"west": "west",
+	"westwarts": "westwärts",
+	"westwärts": "westwärts",
+	"weswegen": "weswegen",
+	"wider": "wider",
+	"wie": "wie",
+	"wieder": "wieder",
+	"wiederum": "wiederum",
+	"wieso": "wieso",
+	"wieviel": "wieviel",
+	"willkommen": "willkommen",
+	"wirklich": "wirklich",
+	"wo": "wo",
+	"woanders": "woanders",
+	"wobei": "wobei",
+	"wochentags": "wochentags",
+	"wodurch": "wodurch",
+	"wofur": "wofür",
+	"wofür": "wofür",
+	"wogegen": "wogegen",
+	"woher": "woher",
+	"wohin": "wohin",
+	"wohinter": "wohinter",
+	"wohl": "wohl",
+	"wohlan": "wohlan",
+	"wohlgemerkt": "wohlgemerkt",
+	"wohlweislich": "wohlweislich",
+	"womit": "womit",
+	"womoglich": "womöglich",
+	"womöglich": "womöglich",
+	"wonach": "wonach",
+	"woneben": "woneben",
+	"woran": "woran",
+	"worauf": "worauf",
+	"woraufhin": "woraufhin",
+	"woraus": "woraus",
+	"worin": "worin",
+	"wortgenauestens": "wortgenauestens",
+	"woruber": "worüber",
+	"worum": "worum",
+	"worunter": "worunter",
+	"worüber": "worüber",
+	"wovon": "wovon",
+	"wovor": "wovor",
+	"wozu": "wozu",
+	"wozwischen": "wozwischen",
+	"währenddessen": "währenddessen",
+	"x-mal": "x-mal",
+	"zehnmal": "zehnmal",
+	"zeitlebens": "zeitlebens",
+	"zeitweise": "zeitweise",
+	"ziemlich": "ziemlich",
+	"zirka": "zirka",
+	"zu": "zu",
+	"zuallererst": "zuallererst",
+	"zudem": "zudem",
+	"zueinander": "zueinander",
+	"zuerst": "zuerst",
+	"zufrieden": "zufrieden",
+	"zugegebenermassen": "zugegebenermassen",
+	"zugegebenermaßen": "zugegebenermassen",
+	"zugleich": "zugleich",
+	"zugrunde": "zugrunde",
+	"zugute": "zugute",
+	"zuhaus": "zuhaus",
+	"zuleide": "zuleide",
+	"zuletzt": "zuletzt",
+	"zumal": "zumal",
+	"zumeist": "zumeist",
+	"zumindest": "zuminde",
+	"zumindestens": "zumindestens",
+	"zumute": "zumute",
+	"zunachst": "zunächst",
+	"zunichte": "zunichte",
+	"zunächst": "zunächst",
+	"zurecht": "zurecht",
+	"zuruck": "zurück",
+	"zurück": "zurück",
+	"zusammen": "zusammen",
+	"zuschulden": "zuschulden",
+	"zusehends": "zusehends",
+	"zustande": "zustande",
+	"zutage": "zutage",
+	"zuteil": "zuteil",
+	"zutiefst": "zutiefst",
+	"zuviel": "zuviel",
+	"zuvor": "zuvor",
+	"zuwege": "zuwege",
+	"zuweilen": "zuweilen",
+	"zuwenig": "zuwenig",
+	"zuwider": "zuwider",
+	"zwangsweise": "zwangsweise",
+	"zwanzigmal": "zwanzigmal",
+	"zwar": "zwar",
+	"zweimal": "zweimal",
+	"zweitemal": "zweitemal",
+	"zweitens": "zweitens",
+	"zwischen": "zwischen",
+	"zwischendrin": "zwischendrin",
+	"zwischendurch": "zwischendurch",
+	"ärztlicherseits": "ärztlicherseits",
+	"äusserst": "äusserst",
+	"äußerst": "äusserst",
+	"öffentlich": "öffentlich",
+	"öfter": "öfter",
+	"öfters": "öfters",
+	"übel": "übel",
+	"über": "über",
+	"überahl": "überall",
+	"überall": "überall",
+	"überallher": "überallher",
+	"überallhin": "überallhin",
+	"überaus": "überaus",
+	"überdies": "überdies",
+	"überein": "überein",
+	"übereinander": "übereinander",
+	"überhand": "überhand",
+	"überhaupt": "überhaupt",
+	"übermorgen": "übermorgen",
+	"überraschenderweise": "überraschenderweise",
+	"üblicherweise": "üblicherweise",
+	"übrig": "übrig",
+	"übrigens": "übrigens",
+}
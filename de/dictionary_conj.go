@@ -0,0 +1,69 @@
+// Code generated by dictgen; DO NOT EDIT.
+
+package de
+
+// Dictionary entries of PoS CONJ, map of Form to Lemma
+var dictionaryCONJ = map[string]string{
+	"aber": "aber",
+	"als": "als",
+	"anstatt": "anstatt",
+	"bevor": "bevor",
+	"beziehungsweise": "beziehungsweise",
+	"bis": "bis",
+	"bzw.": "bzw.",
+	"da": "da",
+	"damit": "damit",
+	"das": "das",
+	"dass": "dass",
+	"daß": "dass",
+	"denn": "denn",
+	"desto": "desto",
+	"doch": "doch",
+	"ehe": "ehe",
+	"entweder": "entweder",
+	"falls": "falls",
+	"geschweige": "geschweige",
+	"gleichwohl": "gleichwohl",
+	"indem": "indem",
+	"indes": "indes",
+	"indessen": "indessen",
+	"jedoch": "jedoch",
+	"nachdem": "nachdem",
+	"noch": "noch",
+	"ob": "ob",
+	"obgleich": "obgleich",
+	"obschon": "obschon",
+	"obwohl": "obwohl",
+	"oder": "oder",
+	"ohne": "ohne",
+	"respektive": "respektive",
+	"seit": "seit",
+	"seitdem": "seitdem",
+	"so": "so",
+	"sobald": "sobald",
+	"sofern": "sofern",
+	"solange": "solange",
+	"sondern": "sondern",
+	"soviel": "soviel",
+	"soweit": "sowiet",
+	"sowie": "sowie",
+	"sowohl": "sowohl",
+	"statt": "statt",
+	"um": "um",
+	"umso": "umso",
+	"und": "und",
+	"wahrend": "während",
+	"weder": "weder",
+	"weil": "weil",
+	"wenn": "wenn",
+	"wenngleich": "wenngleich",
+	"wennschon": "wennschon",
+	"wie": "wie",
+	"wiewohl": "wiewohl",
+	"wobei": "wobei",
+	"wogegen": "wogegen",
+	"wohingegen": "wohingegen",
+	"während": "während",
+	"zumal": "zumal",
+	"zwar": "zwar",
+}
@@ -0,0 +1,201 @@
+// Code generated by dictgen; DO NOT EDIT.
+
+package de
+
+// Dictionary entries of PoS DET, map of Form to Lemma
+var dictionaryDET = map[string]string{
+	"all": "aller",
+	"alle": "aller",
+	"allem": "aller",
+	"allen": "aller",
+	"aller": "aller",
+	"allerlei": "allerlei",
+	"alles": "aller",
+	"andere": "anderer",
+	"anderem": "anderer",
+	"anderen": "anderer",
+	"anderer": "anderer",
+	"anderes": "anderer",
+	"beide": "beide",
+	"beidem": "beide",
+	"beiden": "beide",
+	"beider": "beide",
+	"beides": "beide",
+	"das": "der",
+	"dasjenige": "derjenige",
+	"dasselbe": "derselbe",
+	"dein": "dein",
+	"deine": "dein",
+	"deinem": "dein",
+	"deinen": "dein",
+	"deiner": "dein",
+	"deines": "dein",
+	"dem": "der",
+	"demjenigen": "derjenige",
+	"demselben": "derselbe",
+	"den": "der",
+	"denen": "der",
+	"denjenigen": "derjenige",
+	"denselben": "derselbe",
+	"der": "der",
+	"deren": "der",
+	"derer": "der",
+	"dergleichen": "dergleichen",
+	"derjenige": "derjenige",
+	"derjenigen": "derjenige",
+	"derlei": "derlei",
+	"derselbe": "derselbe",
+	"derselben": "derselbe",
+	"des": "der",
+	"desjenigen": "derjenige",
+	"desselben": "derselbe",
+	"dessen": "der",
+	"die": "der",
+	"diejenige": "derjenige",
+	"diejenigen": "derjenige",
+	"diese": "dieser",
+	"dieselbe": "derselbe",
+	"dieselben": "derselbe",
+	"diesem": "dieser",
+	"diesen": "dieser",
+	"dieser": "dieser",
+	"dieses": "dieser",
+	"ebendiese": "dieser",
+	"ebendiesem": "ebendieser",
+	"ebendiesen": "ebendieser",
+	"ebendieser": "ebendieser",
+	"ebendieses": "ebendieser",
+	"ein": "ein",
+	"eine": "ein",
+	"einem": "ein",
+	"einen": "ein",
+	"einer": "ein",
+	"einerlei": "einerlei",
+	"eines": "ein",
+	"einige": "einiger",
+	"einigem": "einiger",
+	"einigen": "einiger",
+	"einiger": "einiger",
+	"einiges": "einiger",
+	"etliche": "etlicher",
+	"etlichem": "etlicher",
+	"etlichen": "etlicher",
+	"etlicher": "etlicher",
+	"etliches": "etlicher",
+	"etwas": "etwas",
+	"euer": "euer",
+	"eure": "euer",
+	"eurem": "euer",
+	"euren": "euer",
+	"eurer": "euer",
+	"eures": "euer",
+	"genug": "genug",
+	"ihr": "ihr",
+	"ihre": "ihr",
+	"ihrem": "ihr",
+	"ihren": "ihr",
+	"ihrer": "ihr",
+	"ihres": "ihr",
+	"irgendein": "irgendein",
+	"irgendeine": "irgendein",
+	"irgendeinem": "irgendein",
+	"irgendeinen": "irgendein",
+	"irgendeiner": "irgendein",
+	"irgendeines": "irgendein",
+	"irgendwelche": "irgendwelcher",
+	"irgendwelchem": "irgendwelcher",
+	"irgendwelchen": "irgendwelcher",
+	"irgendwelcher": "irgendwelcher",
+	"irgendwelches": "irgendwelcher",
+	"jede": "jeder",
+	"jedem": "jeder",
+	"jeden": "jeder",
+	"jeder": "jeder",
+	"jedes": "jeder",
+	"jedwede": "jedweder",
+	"jedwedem": "jedweder",
+	"jedweden": "jedweder",
+	"jedweder": "jedweder",
+	"jedwedes": "jedweder",
+	"jegliche": "jeglicher",
+	"jeglichem": "jeglicher",
+	"jeglichen": "jeglicher",
+	"jeglicher": "jeglicher",
+	"jegliches": "jeglicher",
+	"jene": "jener",
+	"jenem": "jener",
+	"jenen": "jener",
+	"jener": "jener",
+	"jenes": "jener",
+	"kein": "kein",
+	"keine": "kein",
+	"keinem": "kein",
+	"keinen": "kein",
+	"keiner": "kein",
+	"keinerlei": "keinerlei",
+	"keines": "kein",
+	"manche": "mancher",
+	"manchem": "mancher",
+	"manchen": "mancher",
+	"mancher": "mancher",
+	"mancherlei": "mancherlei",
+	"manches": "mancher",
+	"mehr": "mehr",
+	"mehrere": "mehrere",
+	"mehreren": "mehrere",
+	"mehrerer": "mehrere",
+	"mein": "mein",
+	"meine": "mein",
+	"meinem": "mein",
+	"meinen": "mein",
+	"meiner": "mein",
+	"meines": "mein",
+	"meisten": "meisten",
+	"nichts": "nichts",
+	"nix": "nichts",
+	"paar": "paar",
+	"samtliche": "sämtlicher",
+	"samtlichem": "sämtlicher",
+	"samtlichen": "sämtlicher",
+	"samtlicher": "sämtlicher",
+	"samtliches": "sämtlicher",
+	"sein": "sein",
+	"seine": "sein",
+	"seinem": "sein",
+	"seinen": "sein",
+	"seiner": "sein",
+	"seines": "sein",
+	"solch": "solcher",
+	"solche": "solcher",
+	"solchem": "solcher",
+	"solchen": "solcher",
+	"solcher": "solcher",
+	"solches": "solcher",
+	"sämtliche": "sämtlicher",
+	"sämtlichem": "sämtlicher",
+	"sämtlichen": "sämtlicher",
+	"sämtlicher": "sämtlicher",
+	"sämtliches": "sämtlicher",
+	"unser": "unser",
+	"unsere": "unser",
+	"unserem": "unser",
+	"unseren": "unser",
+	"unserer": "unser",
+	"unseres": "unser",
+	"viel": "viel",
+	"viele": "viel",
+	"vielem": "viel",
+	"vielen": "viel",
+	"vieler": "viel",
+	"vielerlei": "vielerlei",
+	"vieles": "viel",
+	"welche": "welcher",
+	"welchem": "welcher",
+	"welchen": "welcher",
+	"welcher": "welcher",
+	"welches": "welcher",
+	"wenig": "wenig",
+	"wessen": "was",
+	"wieviel": "wieviel",
+	"wieviele": "wieviel",
+}
@@ -0,0 +1,310 @@
+// Code generated by dictgen; DO NOT EDIT.
+
+package de
+
+// Dictionary entries of PoS PRON, map of Form to Lemma
+var dictionaryPRON = map[string]string{
+	"'s": "es",
+	"alle": "alles",
+	"allem": "aller",
+	"allen": "aller",
+	"aller": "aller",
+	"allerlei": "allerlei",
+	"alles": "aller",
+	"andere": "anderer",
+	"anderem": "anderer",
+	"anderen": "anderer",
+	"anderer": "anderer",
+	"anderes": "anderer",
+	"beide": "beide",
+	"beiden": "beide",
+	"beider": "beide",
+	"das": "der",
+	"dasjenige": "derjenige",
+	"dasselbe": "derselbe",
+	"dein": "dein",
+	"deine": "deine",
+	"deinem": "deiner",
+	"deinen": "deine",
+	"deiner": "deiner",
+	"deines": "deiner",
+	"deins": "deiner",
+	"dem": "der",
+	"demjenigen": "derjenige",
+	"demselben": "derselbe",
+	"den": "der",
+	"denen": "der",
+	"denjenigen": "derjenige",
+	"denselben": "derselbe",
+	"der": "der",
+	"derartige": "derartiges",
+	"derartigem": "derartiges",
+	"derartigen": "derartiges",
+	"derartiger": "derartiges",
+	"derartiges": "derartiges",
+	"deren": "der",
+	"derer": "der",
+	"dergleichen": "dergleichen",
+	"derjenige": "derjenige",
+	"derjenigen": "derjenige",
+	"derlei": "derlei",
+	"derselbe": "derselbe",
+	"derselben": "derselbe",
+	"desjenigen": "derjenige",
+	"desselben": "derselbe",
+	"dessen": "der",
+	"dich": "dich",
+	"die": "der",
+	"diejenige": "derjenige",
+	"diejenigen": "derjenige",
+	"dies": "dieser",
+	"diese": "dieser",
+	"dieselbe": "derselbe",
+	"dieselben": "derselbe",
+	"diesem": "dieser",
+	"diesen": "dieser",
+	"dieser": "dieser",
+	"dieses": "dieser",
+	"dir": "dir",
+	"du": "du",
+	"ebendies": "ebendieser",
+	"ebendiese": "ebendieser",
+	"ebendiesem": "ebendieser",
+	"ebendiesen": "ebendieser",
+	"ebendieser": "ebendieser",
+	"ebendieses": "ebendieser",
+	"einander": "einander",
+	"eine": "einer",
+	"einem": "einer",
+	"einen": "einer",
+	"einer": "einer",
+	"einerlei": "einerlei",
+	"eines": "einer",
+	"einige": "einiger",
+	"einigem": "einiger",
+	"einigen": "einiger",
+	"einiger": "einiger",
+	"einiges": "einiger",
+	"eins": "einer",
+	"einzelne": "einzelnes",
+	"einzelnem": "einzelnes",
+	"einzelnen": "einzelnes",
+	"einzelner": "einzelnes",
+	"einzelnes": "einzelnes",
+	"er": "er",
+	"es": "es",
+	"etliche": "etliches",
+	"etlichem": "etliches",
+	"etlichen": "etliches",
+	"etlicher": "etliches",
+	"etliches": "etliches",
+	"etwas": "etwas",
+	"euch": "euch",
+	"euer": "euer",
+	"eur": "eur",
+	"eure": "eure",
+	"eurem": "eurer",
+	"euren": "eure",
+	"eurer": "eurer",
+	"eures": "eurer",
+	"eurs": "eurer",
+	"ich": "ich",
+	"ihm": "ihm",
+	"ihn": "ihn",
+	"ihnen": "ihnen",
+	"ihr": "ihr",
+	"ihre": "ihre",
+	"ihrem": "ihrer",
+	"ihren": "ihre",
+	"ihrer": "ihrer",
+	"ihres": "ihrer",
+	"ihrs": "ihrer",
+	"inwieweit": "inwieweit",
+	"irgendeine": "irgendeiner",
+	"irgendeinem": "irgendeiner",
+	"irgendeinen": "irgendeiner",
+	"irgendeiner": "irgendeiner",
+	"irgendeines": "irgendeiner",
+	"irgendeins": "irgendeiner",
+	"irgendetwas": "irgendetwas",
+	"irgendjemand": "irgendjemand",
+	"irgendwas": "irgendetwas",
+	"irgendwelche": "irgendwelcher",
+	"irgendwelchem": "irgendwelcher",
+	"irgendwelchen": "irgendwelcher",
+	"irgendwelcher": "irgendwelcher",
+	"irgendwelches": "irgendwelcher",
+	"irgendwem": "irgendwer",
+	"irgendwen": "irgendwer",
+	"irgendwer": "irgendwer",
+	"jede": "jeder",
+	"jedem": "jeder",
+	"jeden": "jeder",
+	"jeder": "jeder",
+	"jedermann": "jedermann",
+	"jedes": "jeder",
+	"jedwede": "jedweder",
+	"jedwedem": "jedweder",
+	"jedweden": "jedweder",
+	"jedweder": "jedweder",
+	"jedwedes": "jedweder",
+	"jegliche": "jeglicher",
+	"jeglichem": "jeglicher",
+	"jeglichen": "jeglicher",
+	"jeglicher": "jeglicher",
+	"jegliches": "jeglicher",
+	"jemand": "jemand",
+	"jemandem": "jemand",
+	"jemanden": "jemand",
+	"jemandes": "jemand",
+	"jemands": "jemand",
+	"jene": "jener",
+	"jenem": "jener",
+	"jenen": "jener",
+	"jener": "jener",
+	"jenes": "jener",
+	"keine": "keiner",
+	"keinem": "keiner",
+	"keinen": "keiner",
+	"keiner": "keiner",
+	"keines": "keiner",
+	"keins": "keiner",
+	"man": "man",
+	"manche": "mancher",
+	"manchem": "mancher",
+	"manchen": "mancher",
+	"mancher": "mancher",
+	"mancherlei": "mancherlei",
+	"manches": "mancher",
+	"mehr": "mehr",
+	"mehrere": "mehrere",
+	"mehrerem": "mehreres",
+	"mehreren": "mehrere",
+	"mehrerer": "mehrere",
+	"mehreres": "mehreres",
+	"mein": "mein",
+	"meine": "meine",
+	"meinem": "meiner",
+	"meinen": "meine",
+	"meiner": "meiner",
+	"meines": "meiner",
+	"meins": "meiner",
+	"meisten": "meisten",
+	"mich": "mich",
+	"mir": "mir",
+	"nichts": "nichts",
+	"niemand": "niemand",
+	"niemandem": "niemand",
+	"niemanden": "niemand",
+	"niemandes": "niemand",
+	"niemands": "niemand",
+	"nix": "nichts",
+	"samtliche": "sämtliches",
+	"samtlichem": "sämtliches",
+	"samtlichen": "sämtliches",
+	"samtlicher": "sämtliches",
+	"samtliches": "sämtliches",
+	"sein": "sein",
+	"seine": "seine",
+	"seinem": "seiner",
+	"seinen": "seine",
+	"seiner": "seiner",
+	"seines": "seiner",
+	"seins": "seiner",
+	"selber": "selber",
+	"selbige": "selbiger",
+	"selbigem": "selbiger",
+	"selbigen": "selbiger",
+	"selbiger": "selbiger",
+	"selbiges": "selbiger",
+	"selbst": "selbst",
+	"sich": "sich",
+	"sie": "sie",
+	"solche": "solcher",
+	"solchem": "solcher",
+	"solchen": "solcher",
+	"solcher": "solcher",
+	"solches": "solcher",
+	"sonstige": "sonstiges",
+	"sonstigem": "sonstiges",
+	"sonstigen": "sonstiges",
+	"sonstiger": "sonstiges",
+	"sonstiges": "sonstiges",
+	"sämtliche": "sämtliches",
+	"sämtlichem": "sämtliches",
+	"sämtlichen": "sämtliches",
+	"sämtlicher": "sämtliches",
+	"sämtliches": "sämtliches",
+	"uns": "uns",
+	"unser": "unser",
+	"unsere": "unsere",
+	"unserem": "unserer",
+	"unseren": "unsere",
+	"unserer": "unserer",
+	"unseres": "unserer",
+	"unsers": "unserer",
+	"viel": "viel",
+	"viele": "viel",
+	"vielem": "viel",
+	"vielen": "viel",
+	"vieler": "viel",
+	"vielerlei": "vielerlei",
+	"vieles": "viel",
+	"wann": "wann",
+	"warum": "warum",
+	"was": "was",
+	"weitere": "weiteres",
+	"weiterem": "weiteres",
+	"weiteren": "weiteres",
+	"weiterer": "weiteres",
+	"weiteres": "weiteres",
+	"welche": "welcher",
+	"welchem": "welcher",
+	"welchen": "welcher",
+	"welcher": "welcher",
+	"welches": "welcher",
+	"wem": "wer",
+	"wen": "wer",
+	"wenig": "weniges",
+	"wenige": "weniges",
+	"wenigem": "weniges",
+	"wenigen": "weniges",
+	"weniger": "weniges",
+	"weniges": "weniges",
+	"wer": "wer",
+	"weshalb": "weshalb",
+	"wessen": "was",
+	"weswegen": "weswegen",
+	"wie": "wie",
+	"wieso": "wieso",
+	"wieviel": "wieviel",
+	"wieviele": "wieviel",
+	"wir": "wir",
+	"wo": "wo",
+	"wobei": "wobei",
+	"wodurch": "wodurch",
+	"wofur": "wofür",
+	"wofür": "wofür",
+	"wogegen": "wogegen",
+	"woher": "woher",
+	"wohin": "wohin",
+	"womit": "womit",
+	"wonach": "wonach",
+	"woran": "woran",
+	"worauf": "worauf",
+	"woraufhin": "woraufhin",
+	"woraus": "woraus",
+	"worin": "worin",
+	"woruber": "worüber",
+	"worum": "worum",
+	"worunter": "worunter",
+	"worüber": "worüber",
+	"wovon": "wovon",
+	"wovor": "wovor",
+	"wozu": "wozu",
+	"zahlreiche": "zahlreiches",
+	"zahlreichem": "zahlreiches",
+	"zahlreichen": "zahlreiches",
+	"zahlreicher": "zahlreiches",
+	"zahlreiches": "zahlreiches",
+}
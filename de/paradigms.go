@@ -0,0 +1,11 @@
+// Code generated by dictgen; DO NOT EDIT.
+
+package de
+
+import lemmatizer "github.com/lang-ai/simple_lemmatizer"
+
+// map of PoS to (map of Lemma to Paradigm)
+var Paradigms = map[lemmatizer.POS]map[string]string{
+	lemmatizer.NOUN: paradigmsNOUN,
+	lemmatizer.VERB: paradigmsVERB,
+}
@@ -0,0 +1,8981 @@
+// Code generated by dictgen; DO NOT EDIT.
+
+package de
+
+// Paradigms entries of PoS VERB, map of Lemma to Paradigm
+var paradigmsVERB = map[string]string{
+	"aalen": "en",
+	"abarbeiten": "en",
+	"abbalgen": "en",
+	"abbauen": "en",
+	"abbehalten": "en",
+	"abbeissen": "en",
+	"abbeizen": "en",
+	"abbekommen": "en",
+	"abberufen": "en",
+	"abbestellen": "en",
+	"abbeten": "en",
+	"abbetteln": "eln",
+	"abbezahlen": "en",
+	"abbiegen": "en",
+	"abbilden": "en",
+	"abbinden": "en",
+	"abbitten": "en",
+	"abblasen": "en",
+	"abblassen": "en",
+	"abbleiben": "en",
+	"abblenden": "en",
+	"abblitzen": "en",
+	"abblättern": "ern",
+	"abblühen": "en",
+	"abbrausen": "en",
+	"abbrechen": "en",
+	"abbremsen": "en",
+	"abbrennen": "en",
+	"abbringen": "en",
+	"abbröckeln": "eln",
+	"abbrühen": "en",
+	"abbuchen": "en",
+	"abbürsten": "en",
+	"abbüssen": "en",
+	"abdachen": "en",
+	"abdampfen": "en",
+	"abdanken": "en",
+	"abdarben": "en",
+	"abdecken": "en",
+	"abdichten": "en",
+	"abdienen": "en",
+	"abdingen": "en",
+	"abdizieren": "en",
+	"abdrehen": "en",
+	"abdriften": "en",
+	"abdrosseln": "eln",
+	"abdrucken": "en",
+	"abdrängen": "en",
+	"abdrücken": "en",
+	"abdunkeln": "eln",
+	"abduschen": "en",
+	"abduzieren": "en",
+	"abdämmen": "en",
+	"abebben": "en",
+	"aberkennen": "en",
+	"abessen": "en",
+	"abfackeln": "eln",
+	"abfahren": "en",
+	"abfallen": "en",
+	"abfangen": "en",
+	"abfassen": "en",
+	"abfedern": "ern",
+	"abfeiern": "ern",
+	"abfeilen": "en",
+	"abfertigen": "en",
+	"abfeuern": "ern",
+	"abfiebern": "ern",
+	"abfilmen": "en",
+	"abfinden": "en",
+	"abfischen": "en",
+	"abflachen": "en",
+	"abflauen": "en",
+	"abfliegen": "en",
+	"abfliessen": "en",
+	"abfluchten": "en",
+	"abfordern": "ern",
+	"abformen": "en",
+	"abfragen": "en",
+	"abfressen": "en",
+	"abfrieren": "en",
+	"abfälschen": "en",
+	"abfärben": "en",
+	"abführen": "en",
+	"abfüllen": "en",
+	"abfüttern": "ern",
+	"abgaunern": "ern",
+	"abgeben": "en",
+	"abgehen": "en",
+	"abgelten": "en",
+	"abgespalten": "en",
+	"abgewinnen": "en",
+	"abgewöhnen": "en",
+	"abgiessen": "en",
+	"abgleichen": "en",
+	"abgleiten": "en",
+	"abgraben": "en",
+	"abgrasen": "en",
+	"abgreifen": "en",
+	"abgrenzen": "en",
+	"abgrätschen": "en",
+	"abgucken": "en",
+	"abhacken": "en",
+	"abhaken": "en",
+	"abhalftern": "ern",
+	"abhalten": "en",
+	"abhandeln": "eln",
+	"abhaspeln": "eln",
+	"abhauen": "en",
+	"abheben": "en",
+	"abheften": "en",
+	"abheilen": "en",
+	"abhelfen": "en",
+	"abhetzen": "en",
+	"abhobeln": "eln",
+	"abholen": "en",
+	"abholzen": "en",
+	"abhorchen": "en",
+	"abhängen": "en",
+	"abhärten": "en",
+	"abhäuten": "en",
+	"abhören": "en",
+	"abjagen": "en",
+	"abkanten": "en",
+	"abkanzeln": "eln",
+	"abkappen": "en",
+	"abkapseln": "eln",
+	"abkarten": "en",
+	"abkassieren": "en",
+	"abkaufen": "en",
+	"abkehren": "en",
+	"abklappern": "ern",
+	"abklatschen": "en",
+	"abklauben": "en",
+	"abkleben": "en",
+	"abklemmen": "en",
+	"abklingen": "en",
+	"abklopfen": "en",
+	"abklären": "en",
+	"abknabbern": "ern",
+	"abknallen": "en",
+	"abknapsen": "en",
+	"abkneifen": "en",
+	"abknicken": "en",
+	"abknipsen": "en",
+	"abknutschen": "en",
+	"abknöpfen": "en",
+	"abkochen": "en",
+	"abkommandieren": "en",
+	"abkommen": "en",
+	"abkoppeln": "eln",
+	"abkotzen": "en",
+	"abkratzen": "en",
+	"abkriegen": "en",
+	"abkupfern": "ern",
+	"abkuppeln": "eln",
+	"abkämmen": "en",
+	"abkämpfen": "en",
+	"abkühlen": "en",
+	"abkürzen": "en",
+	"abküssen": "en",
+	"ablachen": "en",
+	"abladen": "en",
+	"ablagern": "ern",
+	"ablaktieren": "en",
+	"ablassen": "en",
+	"ablatschen": "en",
+	"ablaufen": "en",
+	"ablauten": "en",
+	"ableben": "en",
+	"abledern": "ern",
+	"ablegen": "en",
+	"ablehnen": "en",
+	"ableisten": "en",
+	"ableiten": "en",
+	"ablenken": "en",
+	"ablesen": "en",
+	"ableuchten": "en",
+	"ablichten": "en",
+	"abliefern": "ern",
+	"ablöschen": "en",
+	"ablösen": "en",
+	"abmachen": "en",
+	"abmagern": "ern",
+	"abmalen": "en",
+	"abmarschieren": "en",
+	"abmelden": "en",
+	"abmessen": "en",
+	"abmildern": "ern",
+	"abmischen": "en",
+	"abmontieren": "en",
+	"abmurksen": "en",
+	"abmustern": "ern",
+	"abmähen": "en",
+	"abmühen": "en",
+	"abnabeln": "eln",
+	"abnagen": "en",
+	"abnehmen": "en",
+	"abneigen": "en",
+	"abnutzen": "en",
+	"abnähen": "en",
+	"abonnieren": "en",
+	"abordnen": "en",
+	"abpassen": "en",
+	"abpellen": "en",
+	"abpfeifen": "en",
+	"abpflügen": "en",
+	"abplatzen": "en",
+	"abprallen": "en",
+	"abpressen": "en",
+	"abpuffern": "ern",
+	"abpumpen": "en",
+	"abputzen": "en",
+	"abqualifizieren": "en",
+	"abquetschen": "en",
+	"abquälen": "en",
+	"abrackern": "ern",
+	"abrasieren": "en",
+	"abraten": "en",
+	"abrauschen": "en",
+	"abreagieren": "en",
+	"abrechnen": "en",
+	"abregen": "en",
+	"abreiben": "en",
+	"abreichen": "en",
+	"abreisen": "en",
+	"abreissen": "en",
+	"abreiten": "en",
+	"abrichten": "en",
+	"abriegeln": "eln",
+	"abriffeln": "eln",
+	"abrinden": "en",
+	"abringen": "en",
+	"abroden": "en",
+	"abrollen": "en",
+	"abrudern": "ern",
+	"abrufen": "en",
+	"abrunden": "en",
+	"abrupfen": "en",
+	"abrutschen": "en",
+	"abräumen": "en",
+	"abrücken": "en",
+	"abrühren": "en",
+	"abrüsten": "en",
+	"absacken": "en",
+	"absagen": "en",
+	"absahnen": "en",
+	"absatteln": "eln",
+	"absaufen": "en",
+	"absaugen": "en",
+	"absausen": "en",
+	"abschaben": "en",
+	"abschaffen": "en",
+	"abschalten": "en",
+	"abschauen": "en",
+	"abscheiden": "en",
+	"abscheren": "en",
+	"abscheuern": "ern",
+	"abschieben": "en",
+	"abschiefern": "ern",
+	"abschiessen": "en",
+	"abschinden": "en",
+	"abschirmen": "en",
+	"abschirren": "en",
+	"abschlachten": "en",
+	"abschlaffen": "en",
+	"abschlagen": "en",
+	"abschleifen": "en",
+	"abschleppen": "en",
+	"abschliessen": "en",
+	"abschlämmen": "en",
+	"abschmatzen": "en",
+	"abschmeicheln": "eln",
+	"abschmelzen": "en",
+	"abschmettern": "ern",
+	"abschmieren": "en",
+	"abschminken": "en",
+	"abschnallen": "en",
+	"abschnappen": "en",
+	"abschneiden": "en",
+	"abschnüren": "en",
+	"abschotten": "en",
+	"abschrauben": "en",
+	"abschrecken": "en",
+	"abschreiben": "en",
+	"abschreiten": "en",
+	"abschrägen": "en",
+	"abschröpfen": "en",
+	"abschuften": "en",
+	"abschwatzen": "en",
+	"abschweifen": "en",
+	"abschwellen": "en",
+	"abschwenken": "en",
+	"abschwindeln": "eln",
+	"abschwingen": "en",
+	"abschwirren": "en",
+	"abschwächen": "en",
+	"abschwören": "en",
+	"abschälen": "en",
+	"abschätzen": "en",
+	"abschäumen": "en",
+	"abschöpfen": "en",
+	"abschürfen": "en",
+	"abschütteln": "eln",
+	"absegnen": "en",
+	"absehen": "en",
+	"abseifen": "en",
+	"abseilen": "en",
+	"absenden": "en",
+	"absengen": "en",
+	"absenken": "en",
+	"absetzen": "en",
+	"absichern": "ern",
+	"absingen": "en",
+	"absitzen": "en",
+	"absolvieren": "en",
+	"absondern": "ern",
+	"absorbieren": "en",
+	"abspalten": "en",
+	"abspannen": "en",
+	"absparen": "en",
+	"abspeichern": "ern",
+	"abspeisen": "en",
+	"absperren": "en",
+	"abspiegeln": "eln",
+	"abspielen": "en",
+	"absplittern": "ern",
+	"absprechen": "en",
+	"abspreizen": "en",
+	"absprengen": "en",
+	"abspringen": "en",
+	"abspritzen": "en",
+	"absprühen": "en",
+	"abspulen": "en",
+	"abspülen": "en",
+	"abstammen": "en",
+	"abstatten": "en",
+	"abstauben": "en",
+	"abstechen": "en",
+	"abstecken": "en",
+	"abstehen": "en",
+	"absteifen": "en",
+	"absteigen": "en",
+	"abstellen": "en",
+	"abstempeln": "eln",
+	"absteppen": "en",
+	"absterben": "en",
+	"abstielen": "en",
+	"abstillen": "en",
+	"abstimmen": "en",
+	"abstoppen": "en",
+	"abstossen": "en",
+	"abstottern": "ern",
+	"abstrafen": "en",
+	"abstrahlen": "en",
+	"abstrampeln": "eln",
+	"abstreben": "en",
+	"abstreichen": "en",
+	"abstreifen": "en",
+	"abstreiten": "en",
+	"abströmen": "en",
+	"abstufen": "en",
+	"abstumpfen": "en",
+	"abstürzen": "en",
+	"abstützen": "en",
+	"absuchen": "en",
+	"absäbeln": "eln",
+	"absägen": "en",
+	"abtakeln": "eln",
+	"abtanken": "en",
+	"abtanzen": "en",
+	"abtasten": "en",
+	"abtauchen": "en",
+	"abtauen": "en",
+	"abteilen": "en",
+	"abtelefonieren": "en",
+	"abtippen": "en",
+	"abtragen": "en",
+	"abtransportieren": "en",
+	"abtreiben": "en",
+	"abtrennen": "en",
+	"abtreten": "en",
+	"abtrinken": "en",
+	"abtrocknen": "en",
+	"abtropfen": "en",
+	"abtrotzen": "en",
+	"abtrudeln": "eln",
+	"abträufeln": "eln",
+	"abtun": "n",
+	"abtupfen": "en",
+	"abtönen": "en",
+	"abtöten": "en",
+	"aburteilen": "en",
+	"abverlangen": "en",
+	"abwandeln": "eln",
+	"abwandern": "ern",
+	"abwarten": "en",
+	"abwaschen": "en",
+	"abwechseln": "eln",
+	"abwehren": "en",
+	"abweichen": "en",
+	"abweiden": "en",
+	"abweisen": "en",
+	"abwenden": "en",
+	"abwerben": "en",
+	"abwerfen": "en",
+	"abwerten": "en",
+	"abwetzen": "en",
+	"abwickeln": "eln",
+	"abwiegen": "en",
+	"abwimmeln": "eln",
+	"abwinden": "en",
+	"abwinken": "en",
+	"abwirtschaften": "en",
+	"abwischen": "en",
+	"abwohnen": "en",
+	"abwägen": "en",
+	"abwählen": "en",
+	"abwälzen": "en",
+	"abwärtsgehen": "en",
+	"abwürgen": "en",
+	"abzahlen": "en",
+	"abzapfen": "en",
+	"abzappeln": "eln",
+	"abzeichnen": "en",
+	"abziehen": "en",
+	"abzielen": "en",
+	"abzirkeln": "eln",
+	"abzischen": "en",
+	"abzittern": "ern",
+	"abzupfen": "en",
+	"abzwacken": "en",
+	"abzweigen": "en",
+	"abzwingen": "en",
+	"abzwitschern": "ern",
+	"abzählen": "en",
+	"abzäumen": "en",
+	"abzäunen": "en",
+	"abändern": "ern",
+	"abästen": "en",
+	"achten": "en",
+	"achtgeben": "en",
+	"addieren": "en",
+	"adeln": "eln",
+	"adjustieren": "en",
+	"adoptieren": "en",
+	"adressieren": "en",
+	"adsorbieren": "en",
+	"affektieren": "en",
+	"affirmieren": "en",
+	"agglomerieren": "en",
+	"agglutinieren": "en",
+	"agieren": "en",
+	"agitieren": "en",
+	"ahmen": "en",
+	"ahnden": "en",
+	"ahnen": "en",
+	"akklamieren": "en",
+	"akklimatisieren": "en",
+	"akkommodieren": "en",
+	"akkreditieren": "en",
+	"akkumulieren": "en",
+	"akquirieren": "en",
+	"aktivieren": "en",
+	"aktualisieren": "en",
+	"akupunktieren": "en",
+	"akzentuieren": "en",
+	"akzepieren": "en",
+	"akzeptieren": "en",
+	"alarmieren": "en",
+	"albern": "ern",
+	"alkalisieren": "en",
+	"alkoholisieren": "en",
+	"alleingelassen": "en",
+	"alphabetisieren": "en",
+	"alterieren": "en",
+	"altern": "ern",
+	"alternieren": "en",
+	"amerikanisieren": "en",
+	"amortisieren": "en",
+	"amputieren": "en",
+	"amtieren": "en",
+	"amtsenthoben": "en",
+	"amüsieren": "en",
+	"analysieren": "en",
+	"anbacken": "en",
+	"anbaggern": "ern",
+	"anbahnen": "en",
+	"anbandeln": "eln",
+	"anbauen": "en",
+	"anbehalten": "en",
+	"anbeissen": "en",
+	"anbelangen": "en",
+	"anbequemen": "en",
+	"anberaumen": "en",
+	"anbeten": "en",
+	"anbetreffen": "en",
+	"anbetteln": "eln",
+	"anbiedern": "ern",
+	"anbieten": "en",
+	"anbinden": "en",
+	"anblaffen": "en",
+	"anblasen": "en",
+	"anblicken": "en",
+	"anblinzeln": "eln",
+	"anblitzen": "en",
+	"anbohren": "en",
+	"anbraten": "en",
+	"anbrechen": "en",
+	"anbremsen": "en",
+	"anbrennen": "en",
+	"anbringen": "en",
+	"anbrummen": "en",
+	"anbrüllen": "en",
+	"anbrüten": "en",
+	"andauern": "ern",
+	"andenken": "en",
+	"andeuten": "en",
+	"andichten": "en",
+	"andienen": "en",
+	"andiskutieren": "en",
+	"andrehen": "en",
+	"andringen": "en",
+	"androhen": "en",
+	"andrängen": "en",
+	"anecken": "en",
+	"aneignen": "en",
+	"aneinanderfügen": "en",
+	"aneinandergefügen": "en",
+	"aneinandergeraten": "en",
+	"aneinandergrenzen": "en",
+	"aneinanderhängen": "en",
+	"anekeln": "eln",
+	"anerkennen": "en",
+	"anerziehen": "en",
+	"anfachen": "en",
+	"anfahren": "en",
+	"anfallen": "en",
+	"anfangen": "en",
+	"anfassen": "en",
+	"anfauchen": "en",
+	"anfaulen": "en",
+	"anfaxen": "en",
+	"anfechten": "en",
+	"anfeinden": "en",
+	"anfertigen": "en",
+	"anfeuchten": "en",
+	"anfeuern": "ern",
+	"anfinden": "en",
+	"anfixen": "en",
+	"anflehen": "en",
+	"anfliegen": "en",
+	"anfordern": "ern",
+	"anfragen": "en",
+	"anfressen": "en",
+	"anfreunden": "en",
+	"anfrieren": "en",
+	"anfunkeln": "eln",
+	"anfunken": "en",
+	"anfügen": "en",
+	"anfühlen": "en",
+	"anführen": "en",
+	"anfüllen": "en",
+	"angaffen": "en",
+	"angaloppieren": "en",
+	"angeben": "en",
+	"angedeihen": "en",
+	"angehen": "en",
+	"angehören": "en",
+	"angeln": "eln",
+	"angewiesen": "en",
+	"angewöhnen": "en",
+	"angiessen": "en",
+	"angiften": "en",
+	"angleichen": "en",
+	"angliedern": "ern",
+	"anglotzen": "en",
+	"angreifen": "en",
+	"angrenzen": "en",
+	"angrinsen": "en",
+	"angucken": "en",
+	"angurten": "en",
+	"anhaften": "en",
+	"anhaken": "en",
+	"anhalftern": "ern",
+	"anhalten": "en",
+	"anhauchen": "en",
+	"anhauen": "en",
+	"anheben": "en",
+	"anheften": "en",
+	"anheimeln": "eln",
+	"anheimfallen": "en",
+	"anheimgeben": "en",
+	"anheimstellen": "en",
+	"anheizen": "en",
+	"anheuern": "ern",
+	"anhimmeln": "eln",
+	"anholen": "en",
+	"anhängen": "en",
+	"anhärten": "en",
+	"anhäufen": "en",
+	"anhören": "en",
+	"animalisieren": "en",
+	"animieren": "en",
+	"anjagen": "en",
+	"ankaufen": "en",
+	"ankeilen": "en",
+	"ankern": "ern",
+	"anketten": "en",
+	"anklagen": "en",
+	"anklammern": "ern",
+	"ankleben": "en",
+	"ankleiden": "en",
+	"anklingeln": "eln",
+	"anklingen": "en",
+	"anklopfen": "en",
+	"anknabbern": "ern",
+	"anknacksen": "en",
+	"anknipsen": "en",
+	"anknöpfen": "en",
+	"anknüpfen": "en",
+	"ankohlen": "en",
+	"ankommen": "en",
+	"ankoppeln": "eln",
+	"ankotzen": "en",
+	"ankrallen": "en",
+	"ankreiden": "en",
+	"ankreuzen": "en",
+	"ankuppeln": "eln",
+	"ankurbeln": "eln",
+	"ankämpfen": "en",
+	"anködern": "ern",
+	"ankündigen": "en",
+	"anlachen": "en",
+	"anlagern": "ern",
+	"anlangen": "en",
+	"anlassen": "en",
+	"anlasten": "en",
+	"anlaufen": "en",
+	"anlauten": "en",
+	"anlegen": "en",
+	"anlehnen": "en",
+	"anleimen": "en",
+	"anleinen": "en",
+	"anleiten": "en",
+	"anlernen": "en",
+	"anlesen": "en",
+	"anleuchten": "en",
+	"anliefern": "ern",
+	"anliegen": "en",
+	"anlocken": "en",
+	"anlächeln": "eln",
+	"anläuten": "en",
+	"anlöten": "en",
+	"anlügen": "en",
+	"anmachen": "en",
+	"anmahnen": "en",
+	"anmalen": "en",
+	"anmarschieren": "en",
+	"anmassen": "en",
+	"anmaulen": "en",
+	"anmelden": "en",
+	"anmerken": "en",
+	"anmessen": "en",
+	"anmieten": "en",
+	"anmotzen": "en",
+	"anmustern": "ern",
+	"anmuten": "en",
+	"annageln": "eln",
+	"annagen": "en",
+	"annehmen": "en",
+	"annektieren": "en",
+	"annieten": "en",
+	"annoncieren": "en",
+	"annullieren": "en",
+	"annähen": "en",
+	"annähern": "ern",
+	"anordnen": "en",
+	"anpacken": "en",
+	"anpaddeln": "eln",
+	"anpassen": "en",
+	"anpeilen": "en",
+	"anpfeifen": "en",
+	"anpflanzen": "en",
+	"anpflaumen": "en",
+	"anpirschen": "en",
+	"anprallen": "en",
+	"anprangern": "ern",
+	"anpreisen": "en",
+	"anpressen": "en",
+	"anprobieren": "en",
+	"anpumpen": "en",
+	"anputzen": "en",
+	"anpöbeln": "eln",
+	"anquälen": "en",
+	"anranzen": "en",
+	"anraten": "en",
+	"anrauhen": "en",
+	"anrechnen": "en",
+	"anreden": "en",
+	"anregen": "en",
+	"anregieren": "en",
+	"anreiben": "en",
+	"anreichen": "en",
+	"anreichern": "ern",
+	"anreihen": "en",
+	"anreisen": "en",
+	"anreissen": "en",
+	"anreiten": "en",
+	"anreizen": "en",
+	"anrempeln": "eln",
+	"anrennen": "en",
+	"anrichten": "en",
+	"anrollen": "en",
+	"anrudern": "ern",
+	"anrufen": "en",
+	"anrücken": "en",
+	"anrühren": "en",
+	"ansagen": "en",
+	"ansamen": "en",
+	"ansammeln": "eln",
+	"ansaufen": "en",
+	"ansaugen": "en",
+	"anschaffen": "en",
+	"anschalten": "en",
+	"anschauen": "en",
+	"anscheinen": "en",
+	"anscheissen": "en",
+	"anschicken": "en",
+	"anschieben": "en",
+	"anschiessen": "en",
+	"anschiffen": "en",
+	"anschirren": "en",
+	"anschlagen": "en",
+	"anschleichen": "en",
+	"anschleifen": "en",
+	"anschliessen": "en",
+	"anschlämmen": "en",
+	"anschmeissen": "en",
+	"anschmiegen": "en",
+	"anschmieren": "en",
+	"anschnallen": "en",
+	"anschnauzen": "en",
+	"anschneiden": "en",
+	"anschrauben": "en",
+	"anschreiben": "en",
+	"anschreien": "en",
+	"anschrägen": "en",
+	"anschweigen": "en",
+	"anschweissen": "en",
+	"anschwellen": "en",
+	"anschwemmen": "en",
+	"anschwimmen": "en",
+	"anschwindeln": "eln",
+	"anschwärzen": "en",
+	"ansehen": "en",
+	"anseilen": "en",
+	"ansengen": "en",
+	"ansetzen": "en",
+	"ansiedeln": "eln",
+	"ansingen": "en",
+	"ansitzen": "en",
+	"ansohlen": "en",
+	"anspannen": "en",
+	"ansparen": "en",
+	"anspeien": "en",
+	"anspielen": "en",
+	"anspitzen": "en",
+	"anspornen": "en",
+	"ansprechen": "en",
+	"ansprengen": "en",
+	"anspringen": "en",
+	"anspritzen": "en",
+	"ansprühen": "en",
+	"anspülen": "en",
+	"anstacheln": "eln",
+	"anstammen": "en",
+	"anstarren": "en",
+	"anstauben": "en",
+	"anstauen": "en",
+	"anstechen": "en",
+	"anstecken": "en",
+	"anstehen": "en",
+	"ansteigen": "en",
+	"anstellen": "en",
+	"anstemmen": "en",
+	"ansteuern": "ern",
+	"anstiften": "en",
+	"anstimmen": "en",
+	"anstinken": "en",
+	"anstossen": "en",
+	"anstrahlen": "en",
+	"anstreben": "en",
+	"anstreichen": "en",
+	"anstrengen": "en",
+	"anstürmen": "en",
+	"ansuchen": "en",
+	"ansäen": "en",
+	"ansägen": "en",
+	"ansäuern": "ern",
+	"ansäuseln": "eln",
+	"antanzen": "en",
+	"antasten": "en",
+	"antesten": "en",
+	"antippen": "en",
+	"antizipieren": "en",
+	"antraben": "en",
+	"antragen": "en",
+	"antrauen": "en",
+	"antreffen": "en",
+	"antreiben": "en",
+	"antreten": "en",
+	"antrinken": "en",
+	"antun": "n",
+	"antworten": "en",
+	"antönen": "en",
+	"antörnen": "en",
+	"anvertrauen": "en",
+	"anvisieren": "en",
+	"anwachsen": "en",
+	"anwandeln": "eln",
+	"anwehen": "en",
+	"anweisen": "en",
+	"anwenden": "en",
+	"anwerben": "en",
+	"anwerfen": "en",
+	"anwidern": "ern",
+	"anwinkeln": "eln",
+	"anwohnen": "en",
+	"anwurzeln": "eln",
+	"anwählen": "en",
+	"anwärmen": "en",
+	"anzahlen": "en",
+	"anzapfen": "en",
+	"anzaubern": "ern",
+	"anzeichnen": "en",
+	"anzeigen": "en",
+	"anzetteln": "eln",
+	"anziehen": "en",
+	"anzweifeln": "eln",
+	"anzählen": "en",
+	"anzünden": "en",
+	"anöden": "en",
+	"appelieren": "en",
+	"appellieren": "en",
+	"applaudieren": "en",
+	"apportieren": "en",
+	"appretieren": "en",
+	"arbeiten": "en",
+	"archivieren": "en",
+	"argumentieren": "en",
+	"argwöhnen": "en",
+	"aromatisieren": "en",
+	"arrangieren": "en",
+	"arrestieren": "en",
+	"arten": "en",
+	"artikulieren": "en",
+	"asphaltieren": "en",
+	"assimilieren": "en",
+	"assistieren": "en",
+	"assoziieren": "en",
+	"atmen": "en",
+	"atomisieren": "en",
+	"attackieren": "en",
+	"attestieren": "en",
+	"aufarbeiten": "en",
+	"aufatmen": "en",
+	"aufbacken": "en",
+	"aufbahren": "en",
+	"aufbauen": "en",
+	"aufbauschen": "en",
+	"aufbegehren": "en",
+	"aufbehalten": "en",
+	"aufbeissen": "en",
+	"aufbekommen": "en",
+	"aufbereiten": "en",
+	"aufbeschwören": "en",
+	"aufbessern": "ern",
+	"aufbetten": "en",
+	"aufbewahren": "en",
+	"aufbiegen": "en",
+	"aufbieten": "en",
+	"aufbinden": "en",
+	"aufblasen": "en",
+	"aufbleiben": "en",
+	"aufblenden": "en",
+	"aufblitzen": "en",
+	"aufblähen": "en",
+	"aufblättern": "ern",
+	"aufblühen": "en",
+	"aufbraten": "en",
+	"aufbrauchen": "en",
+	"aufbrausen": "en",
+	"aufbrechen": "en",
+	"aufbrennen": "en",
+	"aufbringen": "en",
+	"aufbrummen": "en",
+	"aufbrühen": "en",
+	"aufbäumen": "en",
+	"aufbügeln": "eln",
+	"aufbürden": "en",
+	"aufdampfen": "en",
+	"aufdecken": "en",
+	"aufdonnern": "ern",
+	"aufdrehen": "en",
+	"aufdrängen": "en",
+	"aufdröseln": "eln",
+	"aufdrücken": "en",
+	"aufdämmen": "en",
+	"aufeinandertreffen": "en",
+	"auferlegen": "en",
+	"auferstehen": "en",
+	"aufessen": "en",
+	"auffahren": "en",
+	"auffallen": "en",
+	"auffangen": "en",
+	"auffassen": "en",
+	"auffinden": "en",
+	"auffischen": "en",
+	"aufflammen": "en",
+	"auffliegen": "en",
+	"auffordern": "ern",
+	"aufforsten": "en",
+	"auffressen": "en",
+	"auffrischen": "en",
+	"auffächern": "ern",
+	"auffädeln": "eln",
+	"auffärben": "en",
+	"aufführen": "en",
+	"auffüllen": "en",
+	"aufgabeln": "eln",
+	"aufgaloppieren": "en",
+	"aufgeben": "en",
+	"aufgehen": "en",
+	"aufgeilen": "en",
+	"aufgiessen": "en",
+	"aufgleisen": "en",
+	"aufgliedern": "ern",
+	"aufglimmen": "en",
+	"aufglühen": "en",
+	"aufgreifen": "en",
+	"aufhaken": "en",
+	"aufhalsen": "en",
+	"aufhalten": "en",
+	"aufhauen": "en",
+	"aufheben": "en",
+	"aufheitern": "ern",
+	"aufheizen": "en",
+	"aufhellen": "en",
+	"aufhetzen": "en",
+	"aufheulen": "en",
+	"aufholen": "en",
+	"aufhorchen": "en",
+	"aufhängen": "en",
+	"aufhärten": "en",
+	"aufhäufen": "en",
+	"aufhören": "en",
+	"aufjagen": "en",
+	"aufjauchzen": "en",
+	"aufkaufen": "en",
+	"aufkeimen": "en",
+	"aufklappen": "en",
+	"aufklaren": "en",
+	"aufklauben": "en",
+	"aufkleben": "en",
+	"aufklicken": "en",
+	"aufklingeln": "eln",
+	"aufklinken": "en",
+	"aufklären": "en",
+	"aufknoten": "en",
+	"aufknöpfen": "en",
+	"aufknüpfen": "en",
+	"aufkochen": "en",
+	"aufkommen": "en",
+	"aufkratzen": "en",
+	"aufkrempeln": "eln",
+	"aufkreuzen": "en",
+	"aufkriegen": "en",
+	"aufkämmen": "en",
+	"aufkündigen": "en",
+	"auflachen": "en",
+	"aufladen": "en",
+	"auflassen": "en",
+	"auflasten": "en",
+	"auflauern": "ern",
+	"auflaufen": "en",
+	"aufleben": "en",
+	"auflegen": "en",
+	"auflehnen": "en",
+	"aufleimen": "en",
+	"auflesen": "en",
+	"aufleuchten": "en",
+	"auflichten": "en",
+	"aufliefern": "ern",
+	"aufliegen": "en",
+	"auflisten": "en",
+	"auflockern": "ern",
+	"auflodern": "ern",
+	"auflösen": "en",
+	"aufmachen": "en",
+	"aufmarschieren": "en",
+	"aufmeisseln": "eln",
+	"aufmerken": "en",
+	"aufmotzen": "en",
+	"aufmucken": "en",
+	"aufmuntern": "ern",
+	"aufmöbeln": "eln",
+	"aufnageln": "eln",
+	"aufnehmen": "en",
+	"aufnähen": "en",
+	"aufopfern": "ern",
+	"aufpassen": "en",
+	"aufpflanzen": "en",
+	"aufpfropfen": "en",
+	"aufplatzen": "en",
+	"aufplustern": "ern",
+	"aufpolieren": "en",
+	"aufpolstern": "ern",
+	"aufpoppen": "en",
+	"aufprallen": "en",
+	"aufprobieren": "en",
+	"aufprägen": "en",
+	"aufpumpen": "en",
+	"aufputschen": "en",
+	"aufputzen": "en",
+	"aufpäppeln": "eln",
+	"aufquellen": "en",
+	"aufraffen": "en",
+	"aufragen": "en",
+	"aufrappeln": "eln",
+	"aufrauchen": "en",
+	"aufrauen": "en",
+	"aufrauhen": "en",
+	"aufrechnen": "en",
+	"aufrechterhalten": "en",
+	"aufregen": "en",
+	"aufreiben": "en",
+	"aufreihen": "en",
+	"aufreissen": "en",
+	"aufreiten": "en",
+	"aufreizen": "en",
+	"aufribbeln": "eln",
+	"aufrichten": "en",
+	"aufrollen": "en",
+	"aufrufen": "en",
+	"aufrunden": "en",
+	"aufräumen": "en",
+	"aufrücken": "en",
+	"aufrühren": "en",
+	"aufrüsten": "en",
+	"aufrütteln": "eln",
+	"aufsagen": "en",
+	"aufsammeln": "eln",
+	"aufsaugen": "en",
+	"aufschauen": "en",
+	"aufschaukeln": "eln",
+	"aufscheinen": "en",
+	"aufscheuchen": "en",
+	"aufscheuern": "ern",
+	"aufschichten": "en",
+	"aufschieben": "en",
+	"aufschiessen": "en",
+	"aufschinden": "en",
+	"aufschlagen": "en",
+	"aufschliessen": "en",
+	"aufschlitzen": "en",
+	"aufschluchzen": "en",
+	"aufschlämmen": "en",
+	"aufschlüsseln": "eln",
+	"aufschmeissen": "en",
+	"aufschnallen": "en",
+	"aufschnappen": "en",
+	"aufschneiden": "en",
+	"aufschnellen": "en",
+	"aufschnüren": "en",
+	"aufschrauben": "en",
+	"aufschrecken": "en",
+	"aufschreiben": "en",
+	"aufschreien": "en",
+	"aufschrumpfen": "en",
+	"aufschwatzen": "en",
+	"aufschweissen": "en",
+	"aufschwemmen": "en",
+	"aufschwingen": "en",
+	"aufschürfen": "en",
+	"aufschütteln": "eln",
+	"aufschütten": "en",
+	"aufsehen": "en",
+	"aufsetzen": "en",
+	"aufseufzen": "en",
+	"aufsitzen": "en",
+	"aufspalten": "en",
+	"aufsparen": "en",
+	"aufspeichern": "ern",
+	"aufsperren": "en",
+	"aufspielen": "en",
+	"aufspiessen": "en",
+	"aufspinnen": "en",
+	"aufsplittern": "ern",
+	"aufsprengen": "en",
+	"aufspringen": "en",
+	"aufspritzen": "en",
+	"aufspulen": "en",
+	"aufspüren": "en",
+	"aufstacheln": "eln",
+	"aufstampfen": "en",
+	"aufstapeln": "eln",
+	"aufstauen": "en",
+	"aufstechen": "en",
+	"aufstecken": "en",
+	"aufstehen": "en",
+	"aufsteigen": "en",
+	"aufstellen": "en",
+	"aufstemmen": "en",
+	"aufstielen": "en",
+	"aufstiften": "en",
+	"aufstocken": "en",
+	"aufstossen": "en",
+	"aufstreben": "en",
+	"aufstreichen": "en",
+	"aufstöbern": "ern",
+	"aufstülpen": "en",
+	"aufstützen": "en",
+	"aufsuchen": "en",
+	"auftakeln": "eln",
+	"auftanken": "en",
+	"auftauchen": "en",
+	"auftauen": "en",
+	"aufteilen": "en",
+	"auftischen": "en",
+	"auftragen": "en",
+	"auftreffen": "en",
+	"auftreiben": "en",
+	"auftrennen": "en",
+	"auftreten": "en",
+	"auftrumpfen": "en",
+	"auftun": "n",
+	"auftürmen": "en",
+	"aufwachen": "en",
+	"aufwachsen": "en",
+	"aufwallen": "en",
+	"aufwalzen": "en",
+	"aufwarten": "en",
+	"aufwaschen": "en",
+	"aufwecken": "en",
+	"aufweichen": "en",
+	"aufweisen": "en",
+	"aufwenden": "en",
+	"aufwerfen": "en",
+	"aufwerten": "en",
+	"aufwiegeln": "eln",
+	"aufwiegen": "en",
+	"aufwinden": "en",
+	"aufwirbeln": "eln",
+	"aufwischen": "en",
+	"aufwärmen": "en",
+	"aufwärtsgehen": "en",
+	"aufwühlen": "en",
+	"aufzehren": "en",
+	"aufzeichnen": "en",
+	"aufzeigen": "en",
+	"aufziehen": "en",
+	"aufzumischen": "en",
+	"aufzwingen": "en",
+	"aufzwirbeln": "eln",
+	"aufzählen": "en",
+	"aufzäumen": "en",
+	"auktionieren": "en",
+	"ausagieren": "en",
+	"ausarbeiten": "en",
+	"ausarten": "en",
+	"ausatmen": "en",
+	"ausbaden": "en",
+	"ausbaggern": "ern",
+	"ausbalancieren": "en",
+	"ausbaldowern": "ern",
+	"ausbauchen": "en",
+	"ausbauen": "en",
+	"ausbedingen": "en",
+	"ausbeissen": "en",
+	"ausbessern": "ern",
+	"ausbetonieren": "en",
+	"ausbeulen": "en",
+	"ausbeuteln": "eln",
+	"ausbeuten": "en",
+	"ausbezahlen": "en",
+	"ausbiegen": "en",
+	"ausbieten": "en",
+	"ausbilden": "en",
+	"ausbitten": "en",
+	"ausblasen": "en",
+	"ausbleiben": "en",
+	"ausbleichen": "en",
+	"ausblenden": "en",
+	"ausbluten": "en",
+	"ausbohren": "en",
+	"ausbomben": "en",
+	"ausbooten": "en",
+	"ausborgen": "en",
+	"ausbraten": "en",
+	"ausbrechen": "en",
+	"ausbreiten": "en",
+	"ausbremsen": "en",
+	"ausbrennen": "en",
+	"ausbringen": "en",
+	"ausbrüten": "en",
+	"ausbuchen": "en",
+	"ausbuchten": "en",
+	"ausbuddeln": "eln",
+	"ausbügeln": "eln",
+	"ausbürgern": "ern",
+	"ausbürsten": "en",
+	"ausbüxen": "en",
+	"auschecken": "en",
+	"ausdauern": "ern",
+	"ausdehnen": "en",
+	"ausdenken": "en",
+	"ausdeuten": "en",
+	"ausdienen": "en",
+	"ausdifferenzieren": "en",
+	"ausdiskutieren": "en",
+	"ausdrehen": "en",
+	"ausdreschen": "en",
+	"ausdringen": "en",
+	"ausdrucken": "en",
+	"ausdrücken": "en",
+	"ausdunsten": "en",
+	"ausdörren": "en",
+	"ausdünnen": "en",
+	"auseinanderbrechen": "en",
+	"auseinanderklaffen": "en",
+	"auseinanderliegen": "en",
+	"auseinanderreissen": "en",
+	"auseinandersetzen": "en",
+	"auseinandertreiben": "en",
+	"auserkiesen": "en",
+	"ausersehen": "en",
+	"auserwählen": "en",
+	"ausessen": "en",
+	"ausfahren": "en",
+	"ausfallen": "en",
+	"ausfasern": "ern",
+	"ausfechten": "en",
+	"ausfegen": "en",
+	"ausfeilen": "en",
+	"ausfertigen": "en",
+	"ausfiltern": "ern",
+	"ausfischen": "en",
+	"ausflaggen": "en",
+	"ausfliegen": "en",
+	"ausfliessen": "en",
+	"ausflippen": "en",
+	"ausflocken": "en",
+	"ausfolgen": "en",
+	"ausformulieren": "en",
+	"ausforschen": "en",
+	"ausfragen": "en",
+	"ausfransen": "en",
+	"ausfressen": "en",
+	"ausfrieren": "en",
+	"ausfugen": "en",
+	"ausfädeln": "eln",
+	"ausfällen": "en",
+	"ausführen": "en",
+	"ausfüllen": "en",
+	"ausfüttern": "ern",
+	"ausgeben": "en",
+	"ausgehebelen": "en",
+	"ausgehen": "en",
+	"ausgeizen": "en",
+	"ausgeschlossen": "en",
+	"ausgestalten": "en",
+	"ausgestorben": "en",
+	"ausgiessen": "en",
+	"ausgleichen": "en",
+	"ausgleiten": "en",
+	"ausgliedern": "ern",
+	"ausglimmen": "en",
+	"ausglitschen": "en",
+	"ausglühen": "en",
+	"ausgraben": "en",
+	"ausgreifen": "en",
+	"ausgrenzen": "en",
+	"ausgucken": "en",
+	"aushaken": "en",
+	"aushalten": "en",
+	"aushandeln": "eln",
+	"ausharren": "en",
+	"aushauchen": "en",
+	"aushauen": "en",
+	"aushebeln": "eln",
+	"ausheben": "en",
+	"ausheilen": "en",
+	"aushelfen": "en",
+	"ausholen": "en",
+	"ausholzen": "en",
+	"aushorchen": "en",
+	"aushungern": "ern",
+	"aushusten": "en",
+	"aushändigen": "en",
+	"aushängen": "en",
+	"aushärten": "en",
+	"aushöhlen": "en",
+	"aushülsen": "en",
+	"auskegeln": "eln",
+	"auskehren": "en",
+	"auskeilen": "en",
+	"auskeimen": "en",
+	"auskeltern": "ern",
+	"auskennen": "en",
+	"auskernen": "en",
+	"ausklammern": "ern",
+	"ausklamüsern": "ern",
+	"auskleben": "en",
+	"auskleiden": "en",
+	"ausklingen": "en",
+	"ausklinken": "en",
+	"ausklopfen": "en",
+	"ausklügeln": "eln",
+	"auskneifen": "en",
+	"ausknipsen": "en",
+	"ausknobeln": "eln",
+	"auskochen": "en",
+	"auskommen": "en",
+	"auskosten": "en",
+	"auskramen": "en",
+	"auskratzen": "en",
+	"auskriechen": "en",
+	"auskugeln": "eln",
+	"auskundschaften": "en",
+	"auskungeln": "eln",
+	"auskuppeln": "eln",
+	"auskurieren": "en",
+	"auskämmen": "en",
+	"auskämpfen": "en",
+	"auskühlen": "en",
+	"auslachen": "en",
+	"ausladen": "en",
+	"auslagern": "ern",
+	"auslangen": "en",
+	"auslassen": "en",
+	"auslasten": "en",
+	"auslatschen": "en",
+	"auslaufen": "en",
+	"auslaugen": "en",
+	"auslauten": "en",
+	"ausleben": "en",
+	"ausleeren": "en",
+	"auslegen": "en",
+	"ausleiden": "en",
+	"ausleiern": "ern",
+	"ausleihen": "en",
+	"auslernen": "en",
+	"auslesen": "en",
+	"ausleuchten": "en",
+	"auslichten": "en",
+	"ausliefern": "ern",
+	"auslosen": "en",
+	"ausloten": "en",
+	"ausläuten": "en",
+	"auslöffeln": "eln",
+	"auslöschen": "en",
+	"auslösen": "en",
+	"auslüften": "en",
+	"ausmachen": "en",
+	"ausmahlen": "en",
+	"ausmalen": "en",
+	"ausmeisseln": "eln",
+	"ausmelken": "en",
+	"ausmerzen": "en",
+	"ausmessen": "en",
+	"ausmisten": "en",
+	"ausmustern": "ern",
+	"ausmünden": "en",
+	"ausnehmen": "en",
+	"ausnutzen": "en",
+	"ausnützen": "en",
+	"auspacken": "en",
+	"ausparken": "en",
+	"auspeitschen": "en",
+	"auspendeln": "eln",
+	"auspfeifen": "en",
+	"auspflanzen": "en",
+	"ausplappern": "ern",
+	"ausplaudern": "ern",
+	"ausplündern": "ern",
+	"auspolstern": "ern",
+	"ausposaunen": "en",
+	"auspowern": "ern",
+	"auspressen": "en",
+	"ausprobieren": "en",
+	"ausprägen": "en",
+	"auspumpen": "en",
+	"auspusten": "en",
+	"ausputzen": "en",
+	"ausquartieren": "en",
+	"ausquatschen": "en",
+	"ausquetschen": "en",
+	"ausradieren": "en",
+	"ausrangieren": "en",
+	"ausrasieren": "en",
+	"ausrasten": "en",
+	"ausrauben": "en",
+	"ausrauchen": "en",
+	"ausrechnen": "en",
+	"ausreden": "en",
+	"ausregnen": "en",
+	"ausreiben": "en",
+	"ausreichen": "en",
+	"ausreifen": "en",
+	"ausreisen": "en",
+	"ausreissen": "en",
+	"ausreiten": "en",
+	"ausreizen": "en",
+	"ausrenken": "en",
+	"ausrichten": "en",
+	"ausroden": "en",
+	"ausrollen": "en",
+	"ausrotten": "en",
+	"ausrufen": "en",
+	"ausruhen": "en",
+	"ausrupfen": "en",
+	"ausrutschen": "en",
+	"ausräuchern": "ern",
+	"ausräumen": "en",
+	"ausrücken": "en",
+	"ausrüsten": "en",
+	"aussagen": "en",
+	"aussalzen": "en",
+	"aussaufen": "en",
+	"aussaugen": "en",
+	"ausschaben": "en",
+	"ausschachteln": "eln",
+	"ausschalen": "en",
+	"ausschalten": "en",
+	"ausscharren": "en",
+	"ausschauen": "en",
+	"ausschaufeln": "eln",
+	"ausscheiden": "en",
+	"ausschellen": "en",
+	"ausschelten": "en",
+	"ausschenken": "en",
+	"ausscheren": "en",
+	"ausschiessen": "en",
+	"ausschiffen": "en",
+	"ausschildern": "ern",
+	"ausschimpfen": "en",
+	"ausschirren": "en",
+	"ausschlachten": "en",
+	"ausschlafen": "en",
+	"ausschlagen": "en",
+	"ausschleimen": "en",
+	"ausschliessen": "en",
+	"ausschlämmen": "en",
+	"ausschlüpfen": "en",
+	"ausschlürfen": "en",
+	"ausschmelzen": "en",
+	"ausschmieren": "en",
+	"ausschnappen": "en",
+	"ausschnauben": "en",
+	"ausschnaufen": "en",
+	"ausschneiden": "en",
+	"ausschneuzen": "en",
+	"ausschnäuzen": "en",
+	"ausschrauben": "en",
+	"ausschreiben": "en",
+	"ausschreien": "en",
+	"ausschreiten": "en",
+	"ausschulen": "en",
+	"ausschwefeln": "eln",
+	"ausschweifen": "en",
+	"ausschweigen": "en",
+	"ausschwemmen": "en",
+	"ausschwenken": "en",
+	"ausschwingen": "en",
+	"ausschwitzen": "en",
+	"ausschwärmen": "en",
+	"ausschälen": "en",
+	"ausschöpfen": "en",
+	"ausschütteln": "eln",
+	"ausschütten": "en",
+	"aussehen": "en",
+	"aussenden": "en",
+	"aussetzen": "en",
+	"aussieben": "en",
+	"aussiedeln": "eln",
+	"aussitzen": "en",
+	"aussondern": "ern",
+	"aussortieren": "en",
+	"ausspannen": "en",
+	"aussparen": "en",
+	"ausspeien": "en",
+	"aussperren": "en",
+	"ausspielen": "en",
+	"ausspionieren": "en",
+	"aussprechen": "en",
+	"aussprengen": "en",
+	"ausspritzen": "en",
+	"aussprühen": "en",
+	"ausspucken": "en",
+	"ausspähen": "en",
+	"ausspülen": "en",
+	"ausstaffieren": "en",
+	"ausstanzen": "en",
+	"ausstatten": "en",
+	"ausstechen": "en",
+	"ausstehen": "en",
+	"aussteifen": "en",
+	"aussteigen": "en",
+	"ausstellen": "en",
+	"aussterben": "en",
+	"aussteuern": "ern",
+	"ausstopfen": "en",
+	"ausstossen": "en",
+	"ausstrahlen": "en",
+	"ausstrecken": "en",
+	"ausstreichen": "en",
+	"ausstreuen": "en",
+	"ausströmen": "en",
+	"ausstülpen": "en",
+	"aussuchen": "en",
+	"aussäen": "en",
+	"aussägen": "en",
+	"aussöhnen": "en",
+	"austarieren": "en",
+	"austauschen": "en",
+	"austeilen": "en",
+	"austilgen": "en",
+	"austoben": "en",
+	"austragen": "en",
+	"austreiben": "en",
+	"austreten": "en",
+	"austricksen": "en",
+	"austrinken": "en",
+	"austrocknen": "en",
+	"austrompeten": "en",
+	"austräumen": "en",
+	"austupfen": "en",
+	"austüfteln": "eln",
+	"ausufern": "ern",
+	"ausverkaufen": "en",
+	"auswachsen": "en",
+	"auswalzen": "en",
+	"auswandern": "ern",
+	"auswaschen": "en",
+	"auswechseln": "eln",
+	"ausweichen": "en",
+	"ausweiden": "en",
+	"ausweinen": "en",
+	"ausweisen": "en",
+	"ausweiten": "en",
+	"auswerfen": "en",
+	"auswerten": "en",
+	"auswetzen": "en",
+	"auswickeln": "eln",
+	"auswiegen": "en",
+	"auswinden": "en",
+	"auswirken": "en",
+	"auswischen": "en",
+	"auswringen": "en",
+	"auswuchten": "en",
+	"auswählen": "en",
+	"auswärmen": "en",
+	"auszahlen": "en",
+	"auszahnen": "en",
+	"auszanken": "en",
+	"auszeichnen": "en",
+	"ausziehen": "en",
+	"auszieren": "en",
+	"auszirkeln": "eln",
+	"auszischen": "en",
+	"auszupfen": "en",
+	"auszählen": "en",
+	"ausüben": "en",
+	"authentifizieren": "en",
+	"authentisieren": "en",
+	"automatisieren": "en",
+	"autorisieren": "en",
+	"avancieren": "en",
+	"avisieren": "en",
+	"axiomatisieren": "en",
+	"backen": "en",
+	"baden": "en",
+	"bagatellisieren": "en",
+	"baggern": "ern",
+	"bahnen": "en",
+	"bahren": "en",
+	"balancieren": "en",
+	"balbieren": "en",
+	"baldowern": "ern",
+	"balgen": "en",
+	"balkanisieren": "en",
+	"ballen": "en",
+	"ballern": "ern",
+	"balsamieren": "en",
+	"balzen": "en",
+	"banalisieren": "en",
+	"bandeln": "eln",
+	"bangen": "en",
+	"bannen": "en",
+	"barbieren": "en",
+	"barren": "en",
+	"barrikadieren": "en",
+	"basieren": "en",
+	"basteln": "eln",
+	"bauchen": "en",
+	"bauchreden": "en",
+	"bauen": "en",
+	"baumeln": "eln",
+	"bauschen": "en",
+	"bausparen": "en",
+	"beabsichtigen": "en",
+	"beachten": "en",
+	"beackern": "ern",
+	"beanspruchen": "en",
+	"beanstanden": "en",
+	"beantragen": "en",
+	"beantworten": "en",
+	"bearbeiten": "en",
+	"beargwöhnen": "en",
+	"beatmen": "en",
+	"beaufsichtigen": "en",
+	"beauftragen": "en",
+	"bebauen": "en",
+	"beben": "en",
+	"bebildern": "ern",
+	"bechern": "ern",
+	"becircen": "en",
+	"bedanken": "en",
+	"bedauern": "ern",
+	"bedecken": "en",
+	"bedenken": "en",
+	"bedeuten": "en",
+	"bedienen": "en",
+	"bedingen": "en",
+	"bedrohen": "en",
+	"bedrucken": "en",
+	"bedrängen": "en",
+	"bedrücken": "en",
+	"beduseln": "eln",
+	"bedürfen": "en",
+	"beehren": "en",
+	"beeilen": "en",
+	"beeindrucken": "en",
+	"beeinflussen": "en",
+	"beeinträchtigen": "en",
+	"beenden": "en",
+	"beerben": "en",
+	"beerdigen": "en",
+	"befahren": "en",
+	"befallen": "en",
+	"befangen": "en",
+	"befassen": "en",
+	"befehlen": "en",
+	"befehligen": "en",
+	"befestigen": "en",
+	"befeuchten": "en",
+	"befeuern": "ern",
+	"befinden": "en",
+	"beflaggen": "en",
+	"beflecken": "en",
+	"befleissen": "en",
+	"beflügeln": "eln",
+	"befolgen": "en",
+	"befrachten": "en",
+	"befragen": "en",
+	"befreien": "en",
+	"befremden": "en",
+	"befriedigen": "en",
+	"befristen": "en",
+	"befruchten": "en",
+	"befugen": "en",
+	"befummeln": "eln",
+	"befähigen": "en",
+	"befördern": "ern",
+	"befürchten": "en",
+	"befürsorgen": "en",
+	"befürworten": "en",
+	"begaffen": "en",
+	"begatten": "en",
+	"begeben": "en",
+	"begegnen": "en",
+	"begehen": "en",
+	"begehren": "en",
+	"begeistern": "ern",
+	"begiessen": "en",
+	"beginnen": "en",
+	"beglaubigen": "en",
+	"begleichen": "en",
+	"begleiten": "en",
+	"beglotzen": "en",
+	"beglupschen": "en",
+	"beglücken": "en",
+	"beglückwünschen": "en",
+	"begnadigen": "en",
+	"begnügen": "en",
+	"begraben": "en",
+	"begrabschen": "en",
+	"begradigen": "en",
+	"begreifen": "en",
+	"begrenzen": "en",
+	"begründen": "en",
+	"begrünen": "en",
+	"begrüssen": "en",
+	"begucken": "en",
+	"begutachten": "en",
+	"begünstigen": "en",
+	"begütern": "ern",
+	"behafteen": "en",
+	"behaften": "en",
+	"behagen": "en",
+	"behalten": "en",
+	"behandeln": "eln",
+	"beharren": "en",
+	"behauen": "en",
+	"behaupten": "en",
+	"behausen": "en",
+	"beheben": "en",
+	"beheizen": "en",
+	"behelfen": "en",
+	"behelligen": "en",
+	"beherbergen": "en",
+	"beherrschen": "en",
+	"beherzigen": "en",
+	"behexen": "en",
+	"behindern": "ern",
+	"behängen": "en",
+	"behüten": "en",
+	"beibehalten": "en",
+	"beibiegen": "en",
+	"beibringen": "en",
+	"beichten": "en",
+	"beidrehen": "en",
+	"beieinanderstehen": "en",
+	"beifolgen": "en",
+	"beifügen": "en",
+	"beigeben": "en",
+	"beigesellen": "en",
+	"beiholen": "en",
+	"beikommen": "en",
+	"beiladen": "en",
+	"beilegen": "en",
+	"beiliegen": "en",
+	"beimengen": "en",
+	"beimessen": "en",
+	"beimischen": "en",
+	"beinhalten": "en",
+	"beiordnen": "en",
+	"beipflichten": "en",
+	"beirren": "en",
+	"beischaffen": "en",
+	"beisetzen": "en",
+	"beisitzen": "en",
+	"beispringen": "en",
+	"beissen": "en",
+	"beistehen": "en",
+	"beisteuern": "ern",
+	"beistimmen": "en",
+	"beitragen": "en",
+	"beitreten": "en",
+	"beiwohnen": "en",
+	"beizen": "en",
+	"bejahen": "en",
+	"bejubeln": "eln",
+	"bekakeln": "eln",
+	"bekanntgeben": "en",
+	"bekanntmachen": "en",
+	"bekanntwerden": "en",
+	"bekehren": "en",
+	"bekennen": "en",
+	"beklagen": "en",
+	"beklatschen": "en",
+	"beklauen": "en",
+	"bekleben": "en",
+	"bekleckern": "ern",
+	"beklecksen": "en",
+	"bekleiden": "en",
+	"beklemmen": "en",
+	"beknien": "en",
+	"bekochen": "en",
+	"bekommen": "en",
+	"bekreuzigen": "en",
+	"bekriegen": "en",
+	"bekritteln": "eln",
+	"bekräftigen": "en",
+	"bekrümeln": "eln",
+	"bekunden": "en",
+	"bekämpfen": "en",
+	"beköstigen": "en",
+	"bekürzen": "en",
+	"beladen": "en",
+	"belagern": "ern",
+	"belangen": "en",
+	"belassen": "en",
+	"belasten": "en",
+	"belauern": "ern",
+	"belaufen": "en",
+	"belauschen": "en",
+	"beleben": "en",
+	"belegen": "en",
+	"belehren": "en",
+	"beleidigen": "en",
+	"beleihen": "en",
+	"beleuchten": "en",
+	"belichten": "en",
+	"belieben": "en",
+	"beliefern": "ern",
+	"bellen": "en",
+	"belohnen": "en",
+	"belustigen": "en",
+	"belächeln": "eln",
+	"belämmern": "ern",
+	"belästigen": "en",
+	"belüften": "en",
+	"belügen": "en",
+	"bemalen": "en",
+	"bemannen": "en",
+	"bemerken": "en",
+	"bemessen": "en",
+	"bemitleiden": "en",
+	"bemuttern": "ern",
+	"bemächtigen": "en",
+	"bemängeln": "eln",
+	"bemänteln": "eln",
+	"bemühen": "en",
+	"bemüssigen": "en",
+	"benachrichtigen": "en",
+	"benachteiligen": "en",
+	"benebeln": "eln",
+	"benehmen": "en",
+	"beneiden": "en",
+	"benennen": "en",
+	"benetzen": "en",
+	"benoten": "en",
+	"benutzen": "en",
+	"benötigen": "en",
+	"benützen": "en",
+	"beobachten": "en",
+	"beordern": "ern",
+	"bepacken": "en",
+	"bepflanzen": "en",
+	"bepieseln": "eln",
+	"bepinseln": "eln",
+	"bepissen": "en",
+	"bequemen": "en",
+	"berappen": "en",
+	"beraten": "en",
+	"beratschlagen": "en",
+	"berauben": "en",
+	"beraumen": "en",
+	"berechnen": "en",
+	"berechtigen": "en",
+	"bereden": "en",
+	"bereichern": "ern",
+	"bereifen": "en",
+	"bereinigen": "en",
+	"bereisen": "en",
+	"bereiten": "en",
+	"bereitfinden": "en",
+	"bereithalten": "en",
+	"bereitstehen": "en",
+	"bereitstellen": "en",
+	"bereuen": "en",
+	"bergen": "en",
+	"berichten": "en",
+	"berichtigen": "en",
+	"bersten": "en",
+	"berufen": "en",
+	"beruhen": "en",
+	"beruhigen": "en",
+	"berücksichtigen": "en",
+	"berühren": "en",
+	"berüsten": "en",
+	"besabbern": "ern",
+	"besagen": "en",
+	"besaiten": "en",
+	"besamen": "en",
+	"besaufen": "en",
+	"beschaden": "en",
+	"beschaffen": "en",
+	"beschallen": "en",
+	"beschatten": "en",
+	"beschauen": "en",
+	"bescheiden": "en",
+	"bescheinen": "en",
+	"bescheinigen": "en",
+	"bescheissen": "en",
+	"beschenken": "en",
+	"bescheren": "en",
+	"beschichten": "en",
+	"beschiessen": "en",
+	"beschildern": "ern",
+	"beschimpfen": "en",
+	"beschirmen": "en",
+	"beschlagen": "en",
+	"beschlagnahmen": "en",
+	"beschleichen": "en",
+	"beschleunigen": "en",
+	"beschliessen": "en",
+	"beschmieren": "en",
+	"beschmunzeln": "eln",
+	"beschmutzen": "en",
+	"beschneiden": "en",
+	"beschnuppern": "ern",
+	"beschnüffeln": "eln",
+	"beschreiben": "en",
+	"beschreien": "en",
+	"beschreiten": "en",
+	"beschriften": "en",
+	"beschränken": "en",
+	"beschuhen": "en",
+	"beschuldigen": "en",
+	"beschweren": "en",
+	"beschwichtigen": "en",
+	"beschwören": "en",
+	"beschädigen": "en",
+	"beschäftigen": "en",
+	"beschönigen": "en",
+	"beschützen": "en",
+	"beseelen": "en",
+	"besegeln": "eln",
+	"beseitigen": "en",
+	"besetzen": "en",
+	"besichtigen": "en",
+	"besiedeln": "eln",
+	"besiegeln": "eln",
+	"besiegen": "en",
+	"besingen": "en",
+	"besinnen": "en",
+	"besitzen": "en",
+	"besohlen": "en",
+	"besolden": "en",
+	"besorgen": "en",
+	"bespannen": "en",
+	"bespielen": "en",
+	"bespitzeln": "eln",
+	"besprechen": "en",
+	"besprenkeln": "eln",
+	"bespritzen": "en",
+	"besprühen": "en",
+	"bespucken": "en",
+	"bessern": "ern",
+	"bestatten": "en",
+	"bestaunen": "en",
+	"bestechen": "en",
+	"bestehen": "en",
+	"bestehenbleiben": "en",
+	"bestehlen": "en",
+	"besteigen": "en",
+	"bestellen": "en",
+	"besteuern": "ern",
+	"bestimmen": "en",
+	"bestocken": "en",
+	"bestrafen": "en",
+	"bestrahlen": "en",
+	"bestreben": "en",
+	"bestreiken": "en",
+	"bestreiten": "en",
+	"bestreuen": "en",
+	"bestuhlen": "en",
+	"bestärken": "en",
+	"bestätigen": "en",
+	"bestäuben": "en",
+	"bestöhnen": "en",
+	"bestürzen": "en",
+	"besuchen": "en",
+	"besudeln": "eln",
+	"beswingen": "en",
+	"besänftigen": "en",
+	"betanken": "en",
+	"betatschen": "en",
+	"beteiligen": "en",
+	"beten": "en",
+	"beteuern": "ern",
+	"betiteln": "eln",
+	"betonen": "en",
+	"betonieren": "en",
+	"betrachten": "en",
+	"betragen": "en",
+	"betrauen": "en",
+	"betrauern": "ern",
+	"betreffen": "en",
+	"betreiben": "en",
+	"betreten": "en",
+	"betreuen": "en",
+	"betrinken": "en",
+	"beträufeln": "eln",
+	"betrüben": "en",
+	"betrügen": "en",
+	"betteln": "eln",
+	"betten": "en",
+	"betupfen": "en",
+	"betätigen": "en",
+	"betäuben": "en",
+	"betören": "en",
+	"beugen": "en",
+	"beulen": "en",
+	"beunruhigen": "en",
+	"beurkunden": "en",
+	"beurlauben": "en",
+	"beurteilen": "en",
+	"beuteln": "eln",
+	"beuten": "en",
+	"bevollmächtigen": "en",
+	"bevormunden": "en",
+	"bevorstehen": "en",
+	"bevorteilen": "en",
+	"bevorzugen": "en",
+	"bevölkern": "ern",
+	"bewachen": "en",
+	"bewachsen": "en",
+	"bewaffnen": "en",
+	"bewahren": "en",
+	"bewahrheiten": "en",
+	"bewegen": "en",
+	"beweihräuchern": "ern",
+	"beweinen": "en",
+	"beweisen": "en",
+	"bewenden": "en",
+	"bewerben": "en",
+	"bewerfen": "en",
+	"bewerkstelligen": "en",
+	"bewerten": "en",
+	"bewilligen": "en",
+	"bewirken": "en",
+	"bewirten": "en",
+	"bewirtschaften": "en",
+	"bewohnen": "en",
+	"bewuchern": "ern",
+	"bewundern": "ern",
+	"bewähren": "en",
+	"bewältigen": "en",
+	"bewässern": "ern",
+	"bewölken": "en",
+	"bezahlen": "en",
+	"bezaubern": "ern",
+	"bezeichnen": "en",
+	"bezeugen": "en",
+	"bezichtigen": "en",
+	"beziehen": "en",
+	"beziffern": "ern",
+	"bezuschussen": "en",
+	"bezwecken": "en",
+	"bezweifeln": "eln",
+	"beängstigen": "en",
+	"beäugen": "en",
+	"bibbern": "ern",
+	"bibliographieren": "en",
+	"biedern": "ern",
+	"biegen": "en",
+	"bieten": "en",
+	"bilanzieren": "en",
+	"bilden": "en",
+	"bildhauern": "ern",
+	"billigen": "en",
+	"bimmeln": "eln",
+	"bimsen": "en",
+	"bin": "n",
+	"binden": "en",
+	"biologisieren": "en",
+	"bitten": "en",
+	"blaffen": "en",
+	"blamieren": "en",
+	"blasen": "en",
+	"blassen": "en",
+	"blechen": "en",
+	"bleiben": "en",
+	"bleibenlassen": "en",
+	"bleichen": "en",
+	"blenden": "en",
+	"bleuen": "en",
+	"blicken": "en",
+	"blindfliegen": "en",
+	"blinken": "en",
+	"blinzeln": "eln",
+	"blitzen": "en",
+	"blocken": "en",
+	"blockieren": "en",
+	"blosslegen": "en",
+	"blossliegen": "en",
+	"blossstellen": "en",
+	"blubbern": "ern",
+	"bluffen": "en",
+	"bluten": "en",
+	"blähen": "en",
+	"blättern": "ern",
+	"bläuen": "en",
+	"blödeln": "eln",
+	"blöken": "en",
+	"blühen": "en",
+	"bohnern": "ern",
+	"bohren": "en",
+	"bolzen": "en",
+	"bombardieren": "en",
+	"bomben": "en",
+	"bonifizieren": "en",
+	"boomen": "en",
+	"booten": "en",
+	"borgen": "en",
+	"botanisieren": "en",
+	"boxen": "en",
+	"boykottieren": "en",
+	"brabbeln": "eln",
+	"brachliegen": "en",
+	"branden": "en",
+	"brandmarken": "en",
+	"braten": "en",
+	"brauchen": "en",
+	"brauen": "en",
+	"brausen": "en",
+	"brechen": "en",
+	"breiten": "en",
+	"breitmachen": "en",
+	"breitschlagen": "en",
+	"breittreten": "en",
+	"bremsen": "en",
+	"brennen": "en",
+	"brillieren": "en",
+	"bringen": "en",
+	"brodeln": "eln",
+	"brummen": "en",
+	"brutzeln": "eln",
+	"bröckeln": "eln",
+	"brühen": "en",
+	"brüllen": "en",
+	"brüskieren": "en",
+	"brüsten": "en",
+	"brüten": "en",
+	"buchen": "en",
+	"buchstabieren": "en",
+	"buchten": "en",
+	"buddeln": "eln",
+	"bugsieren": "en",
+	"buhen": "en",
+	"buhlen": "en",
+	"bullern": "ern",
+	"bummeln": "eln",
+	"bumsen": "en",
+	"bunkern": "ern",
+	"buttern": "ern",
+	"bändigen": "en",
+	"bäumen": "en",
+	"bölken": "en",
+	"böllern": "ern",
+	"bücken": "en",
+	"büffeln": "eln",
+	"bügeln": "eln",
+	"bündeln": "eln",
+	"bürden": "en",
+	"bürgen": "en",
+	"bürgern": "ern",
+	"bürokratisieren": "en",
+	"bürsten": "en",
+	"büssen": "en",
+	"büxen": "en",
+	"campieren": "en",
+	"carbonisieren": "en",
+	"changieren": "en",
+	"charakterisieren": "en",
+	"chartern": "ern",
+	"chauffieren": "en",
+	"checken": "en",
+	"clonen": "en",
+	"codieren": "en",
+	"coproduzieren": "en",
+	"cremen": "en",
+	"dabehalten": "en",
+	"dabeibleiben": "en",
+	"dabeisein": "n",
+	"dabeisitzen": "en",
+	"dableiben": "en",
+	"dachen": "en",
+	"dafürhalten": "en",
+	"dafürstehen": "en",
+	"dagegenhalten": "en",
+	"dagegenhandeln": "eln",
+	"dagegenwirken": "en",
+	"daherfliegen": "en",
+	"daherkommen": "en",
+	"daherlaufen": "en",
+	"daherreden": "en",
+	"dahindämmern": "ern",
+	"dahineilen": "en",
+	"dahinfahren": "en",
+	"dahinfliegen": "en",
+	"dahingeben": "en",
+	"dahingehen": "en",
+	"dahinleben": "en",
+	"dahinraffen": "en",
+	"dahinsagen": "en",
+	"dahinscheiden": "en",
+	"dahinschleppen": "en",
+	"dahinschmelzen": "en",
+	"dahinschwinden": "en",
+	"dahinsiechen": "en",
+	"dahinsinken": "en",
+	"dahinstellen": "en",
+	"dahinterklemmen": "en",
+	"dahinterknien": "en",
+	"dahinterkommen": "en",
+	"dahinterstehen": "en",
+	"dahinwelken": "en",
+	"dalassen": "en",
+	"daliegen": "en",
+	"dameln": "eln",
+	"dampfen": "en",
+	"danebenbenehmen": "en",
+	"danebenfallen": "en",
+	"danebenhauchen": "en",
+	"danebenhauen": "en",
+	"danebenliegen": "en",
+	"danebenschiessen": "en",
+	"danken": "en",
+	"darankleben": "en",
+	"daransetzen": "en",
+	"darben": "en",
+	"darbieten": "en",
+	"darbringen": "en",
+	"darinsitzen": "en",
+	"darlegen": "en",
+	"darreichen": "en",
+	"darstellen": "en",
+	"darunterbleiben": "en",
+	"darunterfallen": "en",
+	"darunterheben": "en",
+	"darunterschieben": "en",
+	"darunterschreiben": "en",
+	"daruntersetzen": "en",
+	"darzutun": "n",
+	"darüberfahren": "en",
+	"darüberlegen": "en",
+	"darüberschreiben": "en",
+	"darüberstehen": "en",
+	"dasein": "n",
+	"dasitzen": "en",
+	"dastehen": "en",
+	"datieren": "en",
+	"dauern": "ern",
+	"davonbleiben": "en",
+	"davoneilen": "en",
+	"davonfahren": "en",
+	"davonfliegen": "en",
+	"davonkommen": "en",
+	"davonlaufen": "en",
+	"davonmachen": "en",
+	"davonrudern": "ern",
+	"davonschleichen": "en",
+	"davonschwimmen": "en",
+	"davonstehlen": "en",
+	"davontragen": "en",
+	"davonziehen": "en",
+	"davorlegen": "en",
+	"davorliegen": "en",
+	"davorstehen": "en",
+	"davorstellen": "en",
+	"dazugehören": "en",
+	"dazugewinnen": "en",
+	"dazukommen": "en",
+	"dazulernen": "en",
+	"dazuschreiben": "en",
+	"dazusetzen": "en",
+	"dazustellen": "en",
+	"dazustossen": "en",
+	"dazuzahlen": "en",
+	"dazuzählen": "en",
+	"dazwischenfahren": "en",
+	"dazwischenfunken": "en",
+	"dazwischengereden": "en",
+	"dazwischengerufen": "en",
+	"dazwischenreden": "en",
+	"dazwischenrufen": "en",
+	"dazwischenstehen": "en",
+	"dazwischentreten": "en",
+	"debattieren": "en",
+	"debütieren": "en",
+	"deckeln": "eln",
+	"decken": "en",
+	"decodieren": "en",
+	"deduzieren": "en",
+	"deeskalieren": "en",
+	"definieren": "en",
+	"deformieren": "en",
+	"degradieren": "en",
+	"dehnen": "en",
+	"deichen": "en",
+	"deichseln": "eln",
+	"dejustieren": "en",
+	"dekartellieren": "en",
+	"deklarieren": "en",
+	"deklassieren": "en",
+	"deklinieren": "en",
+	"dekorieren": "en",
+	"dekreditieren": "en",
+	"dekrementieren": "en",
+	"dekretieren": "en",
+	"delegieren": "en",
+	"delektieren": "en",
+	"dellen": "en",
+	"demarkieren": "en",
+	"demaskieren": "en",
+	"demathematisieren": "en",
+	"dementieren": "en",
+	"demilitarisieren": "en",
+	"demissionieren": "en",
+	"demodulieren": "en",
+	"demokratisieren": "en",
+	"demolieren": "en",
+	"demonstrieren": "en",
+	"demontieren": "en",
+	"demoralisieren": "en",
+	"demütigen": "en",
+	"denazifizieren": "en",
+	"denken": "en",
+	"denunzieren": "en",
+	"depersonalisieren": "en",
+	"deplazieren": "en",
+	"deponieren": "en",
+	"deportieren": "en",
+	"deprimieren": "en",
+	"deputieren": "en",
+	"deregulieren": "en",
+	"desavouieren": "en",
+	"desertieren": "en",
+	"desillusionieren": "en",
+	"desinfizieren": "en",
+	"destabilisieren": "en",
+	"determinieren": "en",
+	"detonieren": "en",
+	"deuteln": "eln",
+	"deuten": "en",
+	"deutschen": "en",
+	"dezentralisieren": "en",
+	"dezidieren": "en",
+	"dezimalisieren": "en",
+	"dezimieren": "en",
+	"diagnostizieren": "en",
+	"dialogisieren": "en",
+	"dialysieren": "en",
+	"dichten": "en",
+	"dichtmachen": "en",
+	"dienen": "en",
+	"diffamieren": "en",
+	"differenzieren": "en",
+	"differieren": "en",
+	"diffundieren": "en",
+	"diktieren": "en",
+	"dimensionieren": "en",
+	"dingen": "en",
+	"dinieren": "en",
+	"diplomatisieren": "en",
+	"diplomieren": "en",
+	"dippen": "en",
+	"dirigieren": "en",
+	"diskreditieren": "en",
+	"diskriminieren": "en",
+	"diskutieren": "en",
+	"disponieren": "en",
+	"disqualifizieren": "en",
+	"dissertieren": "en",
+	"dissidieren": "en",
+	"distanzieren": "en",
+	"disziplinieren": "en",
+	"docken": "en",
+	"dogmatisieren": "en",
+	"doktern": "ern",
+	"doktorieren": "en",
+	"dokumentieren": "en",
+	"dolmetschen": "en",
+	"domestizieren": "en",
+	"dominieren": "en",
+	"donnern": "ern",
+	"dopen": "en",
+	"doppeln": "eln",
+	"dosen": "en",
+	"dosieren": "en",
+	"dotieren": "en",
+	"doubeln": "eln",
+	"dozieren": "en",
+	"drahten": "en",
+	"dramatisieren": "en",
+	"dranbleiben": "en",
+	"drangeben": "en",
+	"drangsalieren": "en",
+	"drankleben": "en",
+	"drankommen": "en",
+	"drankriegen": "en",
+	"dranmachen": "en",
+	"drannehmen": "en",
+	"dransetzen": "en",
+	"draufgeben": "en",
+	"draufgehen": "en",
+	"draufhauen": "en",
+	"drauflegen": "en",
+	"drauflosarbeiten": "en",
+	"draufloslaufen": "en",
+	"draufmachen": "en",
+	"draufsetzen": "en",
+	"draufzahlen": "en",
+	"drehen": "en",
+	"dreinfinden": "en",
+	"dreinreden": "en",
+	"dreinschauen": "en",
+	"dreschen": "en",
+	"dressieren": "en",
+	"dribbeln": "eln",
+	"driften": "en",
+	"drillen": "en",
+	"drinbleiben": "en",
+	"dringen": "en",
+	"drinsitzen": "en",
+	"dritteln": "eln",
+	"drohen": "en",
+	"drosseln": "eln",
+	"drucken": "en",
+	"drucksen": "en",
+	"drängeln": "eln",
+	"drängen": "en",
+	"dröhnen": "en",
+	"dröseln": "eln",
+	"drücken": "en",
+	"duchbringen": "en",
+	"ducken": "en",
+	"dudeln": "eln",
+	"duellieren": "en",
+	"duften": "en",
+	"dulden": "en",
+	"dunkeln": "eln",
+	"dunsten": "en",
+	"durcharbeiten": "en",
+	"durchatmen": "en",
+	"durchbeissen": "en",
+	"durchbekommen": "en",
+	"durchbetteln": "eln",
+	"durchbeuteln": "eln",
+	"durchbiegen": "en",
+	"durchbilden": "en",
+	"durchblasen": "en",
+	"durchbleuen": "en",
+	"durchblicken": "en",
+	"durchbluten": "en",
+	"durchblättern": "ern",
+	"durchbläuen": "en",
+	"durchbohren": "en",
+	"durchboxen": "en",
+	"durchbraten": "en",
+	"durchbrausen": "en",
+	"durchbrechen": "en",
+	"durchbrennen": "en",
+	"durchbrettern": "ern",
+	"durchbringen": "en",
+	"durchbürsten": "en",
+	"durchdenken": "en",
+	"durchdiskutieren": "en",
+	"durchdrehen": "en",
+	"durchdringen": "en",
+	"durchdrängen": "en",
+	"durchdrücken": "en",
+	"durcheilen": "en",
+	"durcheinanderbringen": "en",
+	"durchessen": "en",
+	"durchfahren": "en",
+	"durchfallen": "en",
+	"durchfechten": "en",
+	"durchfedern": "ern",
+	"durchfeiern": "ern",
+	"durchfeilen": "en",
+	"durchfinden": "en",
+	"durchflechten": "en",
+	"durchfliegen": "en",
+	"durchfliessen": "en",
+	"durchfluten": "en",
+	"durchflutschen": "en",
+	"durchformen": "en",
+	"durchforsten": "en",
+	"durchfragen": "en",
+	"durchfressen": "en",
+	"durchfrieren": "en",
+	"durchfuttern": "ern",
+	"durchfühlen": "en",
+	"durchführen": "en",
+	"durchfüttern": "ern",
+	"durchgeben": "en",
+	"durchgehen": "en",
+	"durchgliedern": "ern",
+	"durchglühen": "en",
+	"durchgreifen": "en",
+	"durchhalten": "en",
+	"durchhauchen": "en",
+	"durchhauen": "en",
+	"durchhecheln": "eln",
+	"durchheizen": "en",
+	"durchhelfen": "en",
+	"durchholen": "en",
+	"durchhungern": "ern",
+	"durchhängen": "en",
+	"durchhören": "en",
+	"durchjagen": "en",
+	"durchkauen": "en",
+	"durchklingen": "en",
+	"durchkneifen": "en",
+	"durchkneten": "en",
+	"durchknöpfen": "en",
+	"durchkommen": "en",
+	"durchkomponieren": "en",
+	"durchkosten": "en",
+	"durchkreuzen": "en",
+	"durchkriechen": "en",
+	"durchkriegen": "en",
+	"durchkämmen": "en",
+	"durchkämpfen": "en",
+	"durchladen": "en",
+	"durchlangen": "en",
+	"durchlassen": "en",
+	"durchlaufen": "en",
+	"durchleben": "en",
+	"durchlesen": "en",
+	"durchleuchten": "en",
+	"durchliegen": "en",
+	"durchlitten": "en",
+	"durchlöchern": "ern",
+	"durchlüften": "en",
+	"durchlügen": "en",
+	"durchmachen": "en",
+	"durchmarschieren": "en",
+	"durchmessen": "en",
+	"durchnagen": "en",
+	"durchnehmen": "en",
+	"durchnumerieren": "en",
+	"durchorganisieren": "en",
+	"durchpauken": "en",
+	"durchpausen": "en",
+	"durchpeitschen": "en",
+	"durchplumpsen": "en",
+	"durchpressen": "en",
+	"durchproben": "en",
+	"durchprobieren": "en",
+	"durchprüfen": "en",
+	"durchprügeln": "eln",
+	"durchqueren": "en",
+	"durchrasen": "en",
+	"durchrasseln": "eln",
+	"durchrauschen": "en",
+	"durchrechnen": "en",
+	"durchregnen": "en",
+	"durchreiben": "en",
+	"durchreichen": "en",
+	"durchreisen": "en",
+	"durchreissen": "en",
+	"durchreiten": "en",
+	"durchrennen": "en",
+	"durchrieseln": "eln",
+	"durchringen": "en",
+	"durchrosten": "en",
+	"durchrufen": "en",
+	"durchrutschen": "en",
+	"durchrühren": "en",
+	"durchrütteln": "eln",
+	"durchsagen": "en",
+	"durchsaufen": "en",
+	"durchsaugen": "en",
+	"durchsausen": "en",
+	"durchschaben": "en",
+	"durchschallen": "en",
+	"durchschaudern": "ern",
+	"durchschauen": "en",
+	"durchscheinen": "en",
+	"durchscheuern": "ern",
+	"durchschieben": "en",
+	"durchschiessen": "en",
+	"durchschimmern": "ern",
+	"durchschlafen": "en",
+	"durchschlagen": "en",
+	"durchschleichen": "en",
+	"durchschleppen": "en",
+	"durchschleusen": "en",
+	"durchschlingen": "en",
+	"durchschlängeln": "eln",
+	"durchschlüpfen": "en",
+	"durchschmelzen": "en",
+	"durchschmuggeln": "eln",
+	"durchschneiden": "en",
+	"durchschreiben": "en",
+	"durchschreiten": "en",
+	"durchschwimmen": "en",
+	"durchschwindeln": "eln",
+	"durchschwitzen": "en",
+	"durchschütteln": "eln",
+	"durchsegeln": "eln",
+	"durchsehen": "en",
+	"durchsetzen": "en",
+	"durchsickern": "ern",
+	"durchsieben": "en",
+	"durchsingen": "en",
+	"durchsinken": "en",
+	"durchsitzen": "en",
+	"durchspielen": "en",
+	"durchsprechen": "en",
+	"durchspringen": "en",
+	"durchspülen": "en",
+	"durchstarten": "en",
+	"durchstechen": "en",
+	"durchstehen": "en",
+	"durchsteigen": "en",
+	"durchstellen": "en",
+	"durchstimmen": "en",
+	"durchstossen": "en",
+	"durchstreichen": "en",
+	"durchstreifen": "en",
+	"durchströmen": "en",
+	"durchstöbern": "ern",
+	"durchstürmen": "en",
+	"durchsuchen": "en",
+	"durchsägen": "en",
+	"durchtanzen": "en",
+	"durchtesten": "en",
+	"durchtrauen": "en",
+	"durchtreiben": "en",
+	"durchtrennen": "en",
+	"durchtreten": "en",
+	"durchwachsen": "en",
+	"durchwandern": "ern",
+	"durchwaschen": "en",
+	"durchweben": "en",
+	"durchwehen": "en",
+	"durchweichen": "en",
+	"durchwetzen": "en",
+	"durchwinden": "en",
+	"durchwirken": "en",
+	"durchwischen": "en",
+	"durchwursteln": "eln",
+	"durchwählen": "en",
+	"durchwärmen": "en",
+	"durchwühlen": "en",
+	"durchzechen": "en",
+	"durchzeichnen": "en",
+	"durchziehen": "en",
+	"durchzwängen": "en",
+	"durchzählen": "en",
+	"dursten": "en",
+	"duschen": "en",
+	"duseln": "eln",
+	"duzen": "en",
+	"dynamisieren": "en",
+	"dämmen": "en",
+	"dämmern": "ern",
+	"dämpfen": "en",
+	"dörren": "en",
+	"dösen": "en",
+	"dübeln": "eln",
+	"dümpeln": "eln",
+	"düngen": "en",
+	"dünken": "en",
+	"dünnen": "en",
+	"dünsten": "en",
+	"düpieren": "en",
+	"dürfen": "en",
+	"dürsten": "en",
+	"düsen": "en",
+	"ebben": "en",
+	"ebnen": "en",
+	"edieren": "en",
+	"effektivieren": "en",
+	"egalisieren": "en",
+	"ehelichen": "en",
+	"ehren": "en",
+	"eichen": "en",
+	"eieren": "en",
+	"eiern": "ern",
+	"eifern": "ern",
+	"eignen": "en",
+	"eilen": "en",
+	"einarbeiten": "en",
+	"einatmen": "en",
+	"einbalsamieren": "en",
+	"einbauen": "en",
+	"einbehalten": "en",
+	"einbekennen": "en",
+	"einberufen": "en",
+	"einbestellen": "en",
+	"einbetonieren": "en",
+	"einbetten": "en",
+	"einbeziehen": "en",
+	"einbiegen": "en",
+	"einbilden": "en",
+	"einbinden": "en",
+	"einblasen": "en",
+	"einblenden": "en",
+	"einbleuen": "en",
+	"einbläuen": "en",
+	"einbooten": "en",
+	"einbrechen": "en",
+	"einbrennen": "en",
+	"einbringen": "en",
+	"einbrocken": "en",
+	"einbuchten": "en",
+	"einbuddeln": "eln",
+	"einbunkern": "ern",
+	"einbürgern": "ern",
+	"einbüssen": "en",
+	"einchecken": "en",
+	"eincremen": "en",
+	"eindampfen": "en",
+	"eindeichen": "en",
+	"eindellen": "en",
+	"eindeutschen": "en",
+	"eindosen": "en",
+	"eindrehen": "en",
+	"eindrillen": "en",
+	"eindringen": "en",
+	"eindrücken": "en",
+	"eindämmen": "en",
+	"einebnen": "en",
+	"einen": "en",
+	"einengen": "en",
+	"einernten": "en",
+	"einfahren": "en",
+	"einfallen": "en",
+	"einfangen": "en",
+	"einfassen": "en",
+	"einfetten": "en",
+	"einfeuern": "ern",
+	"einfinden": "en",
+	"einflechten": "en",
+	"einfliegen": "en",
+	"einfliessen": "en",
+	"einflippen": "en",
+	"einflössen": "en",
+	"einflüstern": "ern",
+	"einfordern": "ern",
+	"einfressen": "en",
+	"einfrieren": "en",
+	"einfädeln": "eln",
+	"einfärben": "en",
+	"einfügen": "en",
+	"einfühlen": "en",
+	"einführen": "en",
+	"einfüllen": "en",
+	"eingeben": "en",
+	"eingehen": "en",
+	"eingemeinden": "en",
+	"eingestehen": "en",
+	"eingewöhnen": "en",
+	"eingiessen": "en",
+	"eingipsen": "en",
+	"eingittern": "ern",
+	"einglasen": "en",
+	"eingleisen": "en",
+	"eingliedern": "ern",
+	"eingraben": "en",
+	"eingreifen": "en",
+	"eingrenzen": "en",
+	"eingruppieren": "en",
+	"einhaken": "en",
+	"einhalten": "en",
+	"einhandeln": "eln",
+	"einhauen": "en",
+	"einheben": "en",
+	"einheften": "en",
+	"einhegen": "en",
+	"einheilen": "en",
+	"einheimsen": "en",
+	"einheiraten": "en",
+	"einheizen": "en",
+	"einhelfen": "en",
+	"einherbrausen": "en",
+	"einhergehen": "en",
+	"einherjagen": "en",
+	"einherreiten": "en",
+	"einherschlendern": "ern",
+	"einherschreiten": "en",
+	"einhieven": "en",
+	"einholen": "en",
+	"einhändigen": "en",
+	"einhängen": "en",
+	"einhüllen": "en",
+	"einhüten": "en",
+	"einigeln": "eln",
+	"einigen": "en",
+	"einimpfen": "en",
+	"einjagen": "en",
+	"einkacheln": "eln",
+	"einkalkulieren": "en",
+	"einkapseln": "eln",
+	"einkassieren": "en",
+	"einkaufen": "en",
+	"einkehren": "en",
+	"einkeilen": "en",
+	"einkellern": "ern",
+	"einkerben": "en",
+	"einkerkern": "ern",
+	"einkesseln": "eln",
+	"einkitten": "en",
+	"einklagen": "en",
+	"einklammern": "ern",
+	"einkleben": "en",
+	"einkleiden": "en",
+	"einklemmen": "en",
+	"einklinken": "en",
+	"einklopfen": "en",
+	"einkneifen": "en",
+	"einknicken": "en",
+	"einknoten": "en",
+	"einknöpfen": "en",
+	"einknüpfen": "en",
+	"einkochen": "en",
+	"einkomponieren": "en",
+	"einkratzen": "en",
+	"einkreisen": "en",
+	"einkreuzen": "en",
+	"einkriegen": "en",
+	"einladen": "en",
+	"einlagern": "ern",
+	"einlangen": "en",
+	"einlassen": "en",
+	"einlaufen": "en",
+	"einleben": "en",
+	"einlegen": "en",
+	"einleiten": "en",
+	"einlenken": "en",
+	"einlernen": "en",
+	"einlesen": "en",
+	"einleuchten": "en",
+	"einliefern": "ern",
+	"einliegen": "en",
+	"einlochen": "en",
+	"einlullen": "en",
+	"einläuten": "en",
+	"einlösen": "en",
+	"einlöten": "en",
+	"einmachen": "en",
+	"einmahnen": "en",
+	"einmarschieren": "en",
+	"einmauern": "ern",
+	"einmeisseln": "eln",
+	"einmengen": "en",
+	"einmieten": "en",
+	"einmischen": "en",
+	"einmotten": "en",
+	"einmümmeln": "eln",
+	"einmünden": "en",
+	"einnebeln": "eln",
+	"einnehmen": "en",
+	"einnisten": "en",
+	"einnähen": "en",
+	"einnässen": "en",
+	"einoperieren": "en",
+	"einordnen": "en",
+	"einpacken": "en",
+	"einparken": "en",
+	"einpassen": "en",
+	"einpauken": "en",
+	"einpendeln": "eln",
+	"einpferchen": "en",
+	"einpflanzen": "en",
+	"einpfropfen": "en",
+	"einpinseln": "eln",
+	"einplanen": "en",
+	"einpressen": "en",
+	"einprogrammieren": "en",
+	"einprägen": "en",
+	"einpudern": "ern",
+	"einpumpen": "en",
+	"einpökeln": "eln",
+	"einquartieren": "en",
+	"einrahmen": "en",
+	"einrammen": "en",
+	"einrasten": "en",
+	"einrechnen": "en",
+	"einreden": "en",
+	"einregnen": "en",
+	"einreiben": "en",
+	"einreichen": "en",
+	"einreihen": "en",
+	"einreisen": "en",
+	"einreissen": "en",
+	"einreiten": "en",
+	"einrenken": "en",
+	"einrennen": "en",
+	"einrichten": "en",
+	"einriegeln": "eln",
+	"einritzen": "en",
+	"einrollen": "en",
+	"einrosten": "en",
+	"einräuchern": "ern",
+	"einräumen": "en",
+	"einrücken": "en",
+	"einrühren": "en",
+	"einrüsten": "en",
+	"einsacken": "en",
+	"einsagen": "en",
+	"einsalben": "en",
+	"einsalzen": "en",
+	"einsammeln": "eln",
+	"einsargen": "en",
+	"einsauen": "en",
+	"einsaugen": "en",
+	"einschachteln": "eln",
+	"einschalten": "en",
+	"einscharren": "en",
+	"einschenken": "en",
+	"einscheren": "en",
+	"einschieben": "en",
+	"einschiessen": "en",
+	"einschiffen": "en",
+	"einschlafen": "en",
+	"einschlagen": "en",
+	"einschleichen": "en",
+	"einschleifen": "en",
+	"einschleimen": "en",
+	"einschleppen": "en",
+	"einschleusen": "en",
+	"einschliessen": "en",
+	"einschlummern": "ern",
+	"einschläfern": "ern",
+	"einschmeicheln": "eln",
+	"einschmeissen": "en",
+	"einschmelzen": "en",
+	"einschmieren": "en",
+	"einschmuggeln": "eln",
+	"einschnappen": "en",
+	"einschneiden": "en",
+	"einschneien": "en",
+	"einschnitzen": "en",
+	"einschnüren": "en",
+	"einschrauben": "en",
+	"einschreiben": "en",
+	"einschreiten": "en",
+	"einschrumpfen": "en",
+	"einschränken": "en",
+	"einschulen": "en",
+	"einschweben": "en",
+	"einschwefeln": "eln",
+	"einschweissen": "en",
+	"einschwenken": "en",
+	"einschwimmen": "en",
+	"einschwingen": "en",
+	"einschwärzen": "en",
+	"einschwören": "en",
+	"einschärfen": "en",
+	"einschätzen": "en",
+	"einschüchtern": "ern",
+	"einschütten": "en",
+	"einsegnen": "en",
+	"einsehen": "en",
+	"einseifen": "en",
+	"einsenden": "en",
+	"einsengen": "en",
+	"einsetzen": "en",
+	"einsingen": "en",
+	"einsinken": "en",
+	"einsitzen": "en",
+	"einsortieren": "en",
+	"einspannen": "en",
+	"einsparen": "en",
+	"einspeichern": "ern",
+	"einspeisen": "en",
+	"einsperren": "en",
+	"einspielen": "en",
+	"einsprechen": "en",
+	"einsprengen": "en",
+	"einspringen": "en",
+	"einspritzen": "en",
+	"einsprühen": "en",
+	"einstampfen": "en",
+	"einstauben": "en",
+	"einstechen": "en",
+	"einstecken": "en",
+	"einstehen": "en",
+	"einsteigen": "en",
+	"einstellen": "en",
+	"einstimmen": "en",
+	"einstippen": "en",
+	"einstrahlen": "en",
+	"einstreichen": "en",
+	"einstreuen": "en",
+	"einstricken": "en",
+	"einströmen": "en",
+	"einstudieren": "en",
+	"einstufen": "en",
+	"einstülpen": "en",
+	"einstürmen": "en",
+	"einstürzen": "en",
+	"einsägen": "en",
+	"einsäuern": "ern",
+	"einsäumen": "en",
+	"eintanzen": "en",
+	"eintauchen": "en",
+	"eintauschen": "en",
+	"einteilen": "en",
+	"eintopfen": "en",
+	"eintragen": "en",
+	"eintreffen": "en",
+	"eintreiben": "en",
+	"eintreten": "en",
+	"eintrichtern": "ern",
+	"eintrocknen": "en",
+	"eintrudeln": "eln",
+	"einträufeln": "eln",
+	"eintrüben": "en",
+	"eintunken": "en",
+	"eintüten": "en",
+	"einverleiben": "en",
+	"einwachsen": "en",
+	"einwandern": "ern",
+	"einweben": "en",
+	"einwechseln": "eln",
+	"einweichen": "en",
+	"einweihen": "en",
+	"einweisen": "en",
+	"einwenden": "en",
+	"einwerben": "en",
+	"einwerfen": "en",
+	"einwickeln": "eln",
+	"einwiegen": "en",
+	"einwilligen": "en",
+	"einwinken": "en",
+	"einwirken": "en",
+	"einwohnen": "en",
+	"einwurzeln": "eln",
+	"einzahlen": "en",
+	"einzeichnen": "en",
+	"einziehen": "en",
+	"einzwängen": "en",
+	"einzäunen": "en",
+	"einäschern": "ern",
+	"einölen": "en",
+	"einüben": "en",
+	"eisen": "en",
+	"eitern": "ern",
+	"ejakulieren": "en",
+	"ekeln": "eln",
+	"elaborieren": "en",
+	"elektrifizieren": "en",
+	"elektrisieren": "en",
+	"eliminieren": "en",
+	"emanzipieren": "en",
+	"emeritieren": "en",
+	"emigrieren": "en",
+	"emittieren": "en",
+	"emotionalisieren": "en",
+	"empfangen": "en",
+	"empfehlen": "en",
+	"empfinden": "en",
+	"emporarbeiten": "en",
+	"empordringen": "en",
+	"emporfliegen": "en",
+	"emporheben": "en",
+	"emporhelfen": "en",
+	"emporragen": "en",
+	"emporrichten": "en",
+	"emporschauen": "en",
+	"emporschlingen": "en",
+	"emporschnellen": "en",
+	"emporschweben": "en",
+	"emporschwingen": "en",
+	"emporspringen": "en",
+	"emporsteigen": "en",
+	"emporstreben": "en",
+	"emporwinden": "en",
+	"empören": "en",
+	"emulgieren": "en",
+	"enden": "en",
+	"endern": "ern",
+	"engagieren": "en",
+	"engen": "en",
+	"engsetzen": "en",
+	"entalkoholisieren": "en",
+	"entarten": "en",
+	"entautomatisieren": "en",
+	"entbalkanisieren": "en",
+	"entbehren": "en",
+	"entbinden": "en",
+	"entblättern": "ern",
+	"entblössen": "en",
+	"entbrennen": "en",
+	"entcarbonisieren": "en",
+	"entdecken": "en",
+	"entehren": "en",
+	"enteignen": "en",
+	"enteilen": "en",
+	"enteisen": "en",
+	"enterben": "en",
+	"entern": "ern",
+	"entfachen": "en",
+	"entfahren": "en",
+	"entfallen": "en",
+	"entfalten": "en",
+	"entfernen": "en",
+	"entfesseln": "eln",
+	"entfetten": "en",
+	"entfilzen": "en",
+	"entflaggen": "en",
+	"entflammen": "en",
+	"entflattern": "ern",
+	"entfliegen": "en",
+	"entfliehen": "en",
+	"entflutschen": "en",
+	"entformalisieren": "en",
+	"entfrachten": "en",
+	"entfremden": "en",
+	"entfrosten": "en",
+	"entfugen": "en",
+	"entfärben": "en",
+	"entführen": "en",
+	"entgegenarbeiten": "en",
+	"entgegenbringen": "en",
+	"entgegeneilen": "en",
+	"entgegenfahren": "en",
+	"entgegenfiebern": "ern",
+	"entgegenführen": "en",
+	"entgegenhalten": "en",
+	"entgegenhandeln": "eln",
+	"entgegenkommen": "en",
+	"entgegenlaufen": "en",
+	"entgegennehmen": "en",
+	"entgegenreiten": "en",
+	"entgegenrieseln": "eln",
+	"entgegenschallen": "en",
+	"entgegenschlagen": "en",
+	"entgegensehen": "en",
+	"entgegensetzen": "en",
+	"entgegenstehen": "en",
+	"entgegenstellen": "en",
+	"entgegenstürzen": "en",
+	"entgegentragen": "en",
+	"entgegentreiben": "en",
+	"entgegentreten": "en",
+	"entgegenwirken": "en",
+	"entgegnen": "en",
+	"entgehen": "en",
+	"entgiften": "en",
+	"entgleisen": "en",
+	"entgleiten": "en",
+	"entgrenzen": "en",
+	"entgräten": "en",
+	"enthaaren": "en",
+	"enthalten": "en",
+	"enthaupten": "en",
+	"entheben": "en",
+	"enthemmen": "en",
+	"entholzen": "en",
+	"enthärten": "en",
+	"enthäuten": "en",
+	"enthüllen": "en",
+	"entjungfern": "ern",
+	"entkalken": "en",
+	"entkernen": "en",
+	"entklammern": "ern",
+	"entkleiden": "en",
+	"entklumpen": "en",
+	"entkommen": "en",
+	"entkomplizieren": "en",
+	"entkomprimieren": "en",
+	"entkoppeln": "eln",
+	"entkorken": "en",
+	"entkrampfen": "en",
+	"entkräften": "en",
+	"entkräftigen": "en",
+	"entkrümeln": "eln",
+	"entkuppeln": "eln",
+	"entladen": "en",
+	"entlangfahren": "en",
+	"entlangführen": "en",
+	"entlanggehen": "en",
+	"entlanglaufen": "en",
+	"entlangziehen": "en",
+	"entlarven": "en",
+	"entlassen": "en",
+	"entlasten": "en",
+	"entlaufen": "en",
+	"entlausen": "en",
+	"entledigen": "en",
+	"entleeren": "en",
+	"entlehnen": "en",
+	"entleihen": "en",
+	"entliberalisieren": "en",
+	"entloben": "en",
+	"entlocken": "en",
+	"entlohnen": "en",
+	"entlüften": "en",
+	"entmachten": "en",
+	"entmetallisieren": "en",
+	"entmiefen": "en",
+	"entmilitarisieren": "en",
+	"entmisten": "en",
+	"entmonopolisieren": "en",
+	"entmutigen": "en",
+	"entmystifizieren": "en",
+	"entmündigen": "en",
+	"entnaturalisieren": "en",
+	"entnazifizieren": "en",
+	"entnehmen": "en",
+	"entnerven": "en",
+	"entnässen": "en",
+	"entpacken": "en",
+	"entpellen": "en",
+	"entpolitisieren": "en",
+	"entpudern": "ern",
+	"entpuppen": "en",
+	"entraten": "en",
+	"entrauschen": "en",
+	"entreissen": "en",
+	"entrichten": "en",
+	"entriegeln": "eln",
+	"entrinnen": "en",
+	"entrollen": "en",
+	"entrosten": "en",
+	"enträtseln": "eln",
+	"entrücken": "en",
+	"entrümpeln": "eln",
+	"entrüsten": "en",
+	"entsaften": "en",
+	"entsagen": "en",
+	"entscheiden": "en",
+	"entschlafen": "en",
+	"entschleichen": "en",
+	"entschleiern": "ern",
+	"entschleimen": "en",
+	"entschliessen": "en",
+	"entschlummern": "ern",
+	"entschlüpfen": "en",
+	"entschlüsseln": "eln",
+	"entschulden": "en",
+	"entschuldigen": "en",
+	"entschwinden": "en",
+	"entschwirren": "en",
+	"entschädigen": "en",
+	"entschärfen": "en",
+	"entsenden": "en",
+	"entsetzen": "en",
+	"entseuchen": "en",
+	"entsichern": "ern",
+	"entsinken": "en",
+	"entsinnen": "en",
+	"entsorgen": "en",
+	"entspannen": "en",
+	"entsperren": "en",
+	"entspiegeln": "eln",
+	"entsprechen": "en",
+	"entspringen": "en",
+	"entstacheln": "eln",
+	"entstammen": "en",
+	"entstauben": "en",
+	"entstehen": "en",
+	"entsteigen": "en",
+	"entsteinen": "en",
+	"entstellen": "en",
+	"entströmen": "en",
+	"entstören": "en",
+	"enttabuieren": "en",
+	"enttarnen": "en",
+	"enttechnisieren": "en",
+	"entthronen": "en",
+	"enttäuschen": "en",
+	"entwachsen": "en",
+	"entwaffnen": "en",
+	"entwarnen": "en",
+	"entweichen": "en",
+	"entwenden": "en",
+	"entwerfen": "en",
+	"entwerten": "en",
+	"entwickeln": "eln",
+	"entwirren": "en",
+	"entwischen": "en",
+	"entwurzeln": "eln",
+	"entwöhnen": "en",
+	"entzaubern": "ern",
+	"entzerren": "en",
+	"entziehen": "en",
+	"entziffern": "ern",
+	"entzweibeissen": "en",
+	"entzweibrechen": "en",
+	"entzweihauchen": "en",
+	"entzweihauen": "en",
+	"entzweimachen": "en",
+	"entzweireissen": "en",
+	"entzweischlagen": "en",
+	"entzweischneiden": "en",
+	"entzünden": "en",
+	"erachten": "en",
+	"erahnen": "en",
+	"erarbeiten": "en",
+	"erbarmen": "en",
+	"erbauen": "en",
+	"erbeben": "en",
+	"erben": "en",
+	"erbeten": "en",
+	"erbetteln": "eln",
+	"erbeuten": "en",
+	"erbitten": "en",
+	"erblassen": "en",
+	"erbleichen": "en",
+	"erblicken": "en",
+	"erblinden": "en",
+	"erblühen": "en",
+	"erbosen": "en",
+	"erbrechen": "en",
+	"erbringen": "en",
+	"erden": "en",
+	"erdenken": "en",
+	"erdolchen": "en",
+	"erdreisten": "en",
+	"erdrosseln": "eln",
+	"erdrücken": "en",
+	"erdulden": "en",
+	"ereifern": "ern",
+	"ereignen": "en",
+	"ereilen": "en",
+	"erfahren": "en",
+	"erfassen": "en",
+	"erfinden": "en",
+	"erfolgen": "en",
+	"erfordern": "ern",
+	"erforschen": "en",
+	"erfragen": "en",
+	"erfreuen": "en",
+	"erfrieren": "en",
+	"erfrischen": "en",
+	"erfühlen": "en",
+	"erfüllen": "en",
+	"ergattern": "ern",
+	"ergaunern": "ern",
+	"ergeben": "en",
+	"ergehen": "en",
+	"ergiessen": "en",
+	"ergiffen": "en",
+	"ergreifen": "en",
+	"ergründen": "en",
+	"ergänzen": "en",
+	"ergötzen": "en",
+	"erhallen": "en",
+	"erhalten": "en",
+	"erhaschen": "en",
+	"erheben": "en",
+	"erheitern": "ern",
+	"erhellen": "en",
+	"erhitzen": "en",
+	"erhoffen": "en",
+	"erholen": "en",
+	"erhängen": "en",
+	"erhärten": "en",
+	"erhöhen": "en",
+	"erhören": "en",
+	"erinnern": "ern",
+	"erkalten": "en",
+	"erkaufen": "en",
+	"erkennen": "en",
+	"erkiesen": "en",
+	"erklaeren": "en",
+	"erklimmen": "en",
+	"erklingen": "en",
+	"erklären": "en",
+	"erkranken": "en",
+	"erkunden": "en",
+	"erkundigen": "en",
+	"erkälten": "en",
+	"erkämpfen": "en",
+	"erlahmen": "en",
+	"erlangen": "en",
+	"erlassen": "en",
+	"erlauben": "en",
+	"erlaufen": "en",
+	"erleben": "en",
+	"erledigen": "en",
+	"erlegen": "en",
+	"erleichtern": "ern",
+	"erleiden": "en",
+	"erlernen": "en",
+	"erliegen": "en",
+	"erläutern": "ern",
+	"erlöschen": "en",
+	"erlösen": "en",
+	"ermahnen": "en",
+	"ermatten": "en",
+	"ermitteln": "eln",
+	"ermorden": "en",
+	"ermuntern": "ern",
+	"ermutigen": "en",
+	"ermächtigen": "en",
+	"ermässigen": "en",
+	"ermöglichen": "en",
+	"ermüden": "en",
+	"ernennen": "en",
+	"erneuern": "ern",
+	"erniedrigen": "en",
+	"ernten": "en",
+	"ernähren": "en",
+	"erobern": "ern",
+	"erodieren": "en",
+	"erotisieren": "en",
+	"erpichen": "en",
+	"erpressen": "en",
+	"erproben": "en",
+	"erraten": "en",
+	"errechnen": "en",
+	"erregen": "en",
+	"erreichen": "en",
+	"errichten": "en",
+	"erringen": "en",
+	"erröten": "en",
+	"ersaufen": "en",
+	"erschaffen": "en",
+	"erschallen": "en",
+	"erscheinen": "en",
+	"erschiessen": "en",
+	"erschlaffen": "en",
+	"erschlagen": "en",
+	"erschleichen": "en",
+	"erschliessen": "en",
+	"erschrecken": "en",
+	"erschweren": "en",
+	"erschwindeln": "eln",
+	"erschöpfen": "en",
+	"erschüttern": "ern",
+	"ersehen": "en",
+	"ersehnen": "en",
+	"ersetzen": "en",
+	"ersinnen": "en",
+	"ersparen": "en",
+	"erspielen": "en",
+	"erspähen": "en",
+	"erstarken": "en",
+	"erstarren": "en",
+	"erstatten": "en",
+	"erstaunen": "en",
+	"erstechen": "en",
+	"erstehen": "en",
+	"ersteigern": "ern",
+	"erstellen": "en",
+	"ersticken": "en",
+	"erstinken": "en",
+	"erstreben": "en",
+	"erstrecken": "en",
+	"erstreiken": "en",
+	"erstreiten": "en",
+	"ersuchen": "en",
+	"ertappen": "en",
+	"ertasten": "en",
+	"erteilen": "en",
+	"ertragen": "en",
+	"ertrinken": "en",
+	"erträumen": "en",
+	"ertönen": "en",
+	"eruieren": "en",
+	"erwachen": "en",
+	"erwachsen": "en",
+	"erwarten": "en",
+	"erwecken": "en",
+	"erweisen": "en",
+	"erweitern": "ern",
+	"erwerben": "en",
+	"erwidern": "ern",
+	"erwirken": "en",
+	"erwirtschaften": "en",
+	"erwischen": "en",
+	"erwägen": "en",
+	"erwählen": "en",
+	"erwähnen": "en",
+	"erwärmen": "en",
+	"erwürgen": "en",
+	"erzeugen": "en",
+	"erziehen": "en",
+	"erzielen": "en",
+	"erzittern": "ern",
+	"erzwingen": "en",
+	"erzählen": "en",
+	"erzürnen": "en",
+	"eröffnen": "en",
+	"erörtern": "ern",
+	"erübrigen": "en",
+	"eskalieren": "en",
+	"eskortieren": "en",
+	"essen": "en",
+	"etablieren": "en",
+	"etikettieren": "en",
+	"europäisieren": "en",
+	"evakuieren": "en",
+	"evozieren": "en",
+	"exekutieren": "en",
+	"exerzieren": "en",
+	"exhibieren": "en",
+	"exhumieren": "en",
+	"existieren": "en",
+	"expandieren": "en",
+	"experimentieren": "en",
+	"explanieren": "en",
+	"explizieren": "en",
+	"explodieren": "en",
+	"explorieren": "en",
+	"exponieren": "en",
+	"exportieren": "en",
+	"extrahieren": "en",
+	"extrapolieren": "en",
+	"fabrizieren": "en",
+	"fabulieren": "en",
+	"fachen": "en",
+	"fahnden": "en",
+	"fahren": "en",
+	"fakturieren": "en",
+	"fallen": "en",
+	"fallenlassen": "en",
+	"falsifizieren": "en",
+	"falten": "en",
+	"falzen": "en",
+	"fangen": "en",
+	"faseln": "eln",
+	"fasern": "ern",
+	"fassen": "en",
+	"fasten": "en",
+	"faszinieren": "en",
+	"fauchen": "en",
+	"faulen": "en",
+	"faulenzen": "en",
+	"fausten": "en",
+	"favorisieren": "en",
+	"faxen": "en",
+	"fechten": "en",
+	"federn": "ern",
+	"fegen": "en",
+	"fehlbitten": "en",
+	"fehlen": "en",
+	"fehlgreifen": "en",
+	"fehlinvestieren": "en",
+	"fehlleiten": "en",
+	"fehlschiessen": "en",
+	"fehlschlagen": "en",
+	"feien": "en",
+	"feiern": "ern",
+	"feilbieten": "en",
+	"feilen": "en",
+	"feilschen": "en",
+	"feinden": "en",
+	"feinschleifen": "en",
+	"feixen": "en",
+	"ferkeln": "eln",
+	"fernbleiben": "en",
+	"fernhalten": "en",
+	"fernlenken": "en",
+	"fernsehen": "en",
+	"fernsprechen": "en",
+	"fernsteuern": "ern",
+	"fertigbekommen": "en",
+	"fertigbringen": "en",
+	"fertigen": "en",
+	"fertigkochen": "en",
+	"fertigmachen": "en",
+	"fertigrauchen": "en",
+	"fertigstellen": "en",
+	"fesseln": "eln",
+	"festbeissen": "en",
+	"festfahren": "en",
+	"festfressen": "en",
+	"festhaken": "en",
+	"festhalten": "en",
+	"festheften": "en",
+	"festigen": "en",
+	"festkeilen": "en",
+	"festklammern": "ern",
+	"festkleben": "en",
+	"festklemmen": "en",
+	"festklopfen": "en",
+	"festlaufen": "en",
+	"festlegen": "en",
+	"festlesen": "en",
+	"festliegen": "en",
+	"festmachen": "en",
+	"festnageln": "eln",
+	"festnehmen": "en",
+	"festschrauben": "en",
+	"festschreiben": "en",
+	"festsetzen": "en",
+	"festsitzen": "en",
+	"feststecken": "en",
+	"feststehen": "en",
+	"feststellen": "en",
+	"festtreten": "en",
+	"festwurzeln": "eln",
+	"fetischisieren": "en",
+	"fetten": "en",
+	"fetzen": "en",
+	"feuchten": "en",
+	"feuern": "ern",
+	"ficken": "en",
+	"fiebern": "ern",
+	"fiedeln": "eln",
+	"fiedern": "ern",
+	"filetieren": "en",
+	"filmen": "en",
+	"filtern": "ern",
+	"filzen": "en",
+	"finanzieren": "en",
+	"finden": "en",
+	"fingieren": "en",
+	"firmen": "en",
+	"firmieren": "en",
+	"fischen": "en",
+	"fixen": "en",
+	"fixieren": "en",
+	"flachdrücken": "en",
+	"flachen": "en",
+	"flachfallen": "en",
+	"flackern": "ern",
+	"flaggen": "en",
+	"flambieren": "en",
+	"flammen": "en",
+	"flanieren": "en",
+	"flanken": "en",
+	"flankieren": "en",
+	"flanschen": "en",
+	"flattern": "ern",
+	"flauen": "en",
+	"flechten": "en",
+	"flecken": "en",
+	"fleddern": "ern",
+	"flegeln": "eln",
+	"flehen": "en",
+	"flektieren": "en",
+	"flennen": "en",
+	"fletschen": "en",
+	"flexibilisieren": "en",
+	"flicken": "en",
+	"fliegen": "en",
+	"fliehen": "en",
+	"fliesen": "en",
+	"fliessen": "en",
+	"flimmern": "ern",
+	"flippen": "en",
+	"flippern": "ern",
+	"flirten": "en",
+	"flittern": "ern",
+	"flitzen": "en",
+	"flocken": "en",
+	"floppen": "en",
+	"florieren": "en",
+	"flottbekommen": "en",
+	"flottkriegen": "en",
+	"flottmachen": "en",
+	"fluchen": "en",
+	"fluchten": "en",
+	"fluktuieren": "en",
+	"flunkern": "ern",
+	"fluppen": "en",
+	"fluten": "en",
+	"flutschen": "en",
+	"flämmen": "en",
+	"fläzen": "en",
+	"flössen": "en",
+	"flöten": "en",
+	"flötengehen": "en",
+	"flüchten": "en",
+	"flüstern": "ern",
+	"fokussieren": "en",
+	"folen": "en",
+	"folgen": "en",
+	"folgern": "ern",
+	"foltern": "ern",
+	"foppen": "en",
+	"forcieren": "en",
+	"fordern": "ern",
+	"formalisieren": "en",
+	"formatieren": "en",
+	"formen": "en",
+	"formieren": "en",
+	"formulieren": "en",
+	"forschen": "en",
+	"forsten": "en",
+	"fortbegeben": "en",
+	"fortbestehen": "en",
+	"fortbewegen": "en",
+	"fortbilden": "en",
+	"fortblasen": "en",
+	"fortbleiben": "en",
+	"fortbringen": "en",
+	"fortdauern": "ern",
+	"fortdenken": "en",
+	"fortdrängen": "en",
+	"forteilen": "en",
+	"fortfahren": "en",
+	"fortfallen": "en",
+	"fortfliegen": "en",
+	"fortführen": "en",
+	"fortgeben": "en",
+	"fortgiessen": "en",
+	"fortjagen": "en",
+	"fortkommen": "en",
+	"fortkriechen": "en",
+	"fortlassen": "en",
+	"fortleben": "en",
+	"fortmachen": "en",
+	"fortmarschieren": "en",
+	"fortnehmen": "en",
+	"fortpflanzen": "en",
+	"fortreisen": "en",
+	"fortreissen": "en",
+	"fortreiten": "en",
+	"fortrennen": "en",
+	"forträumen": "en",
+	"fortscheren": "en",
+	"fortscheuchen": "en",
+	"fortschieben": "en",
+	"fortschleichen": "en",
+	"fortschleppen": "en",
+	"fortschleudern": "ern",
+	"fortschnellen": "en",
+	"fortschreiben": "en",
+	"fortschreiten": "en",
+	"fortschwimmen": "en",
+	"fortsehnen": "en",
+	"fortsetzen": "en",
+	"fortspringen": "en",
+	"fortstehlen": "en",
+	"fortstürmen": "en",
+	"fortstürzen": "en",
+	"forttragen": "en",
+	"forttreiben": "en",
+	"fortwerfen": "en",
+	"fortwirken": "en",
+	"fortwischen": "en",
+	"fortwähren": "en",
+	"fortwälzen": "en",
+	"fortwünschen": "en",
+	"fortzahlen": "en",
+	"fortzerren": "en",
+	"fotografieren": "en",
+	"fotokopieren": "en",
+	"foulen": "en",
+	"frachten": "en",
+	"fragen": "en",
+	"fraktionieren": "en",
+	"frankieren": "en",
+	"fransen": "en",
+	"franzen": "en",
+	"frappieren": "en",
+	"fraternisieren": "en",
+	"freibekommen": "en",
+	"freigeben": "en",
+	"freihaben": "en",
+	"freihalten": "en",
+	"freikaufen": "en",
+	"freikommen": "en",
+	"freikämpfen": "en",
+	"freilassen": "en",
+	"freilegen": "en",
+	"freimachen": "en",
+	"freipressen": "en",
+	"freischalten": "en",
+	"freischwimmen": "en",
+	"freisetzen": "en",
+	"freispielen": "en",
+	"freisprechen": "en",
+	"freistehen": "en",
+	"freistellen": "en",
+	"freistempeln": "eln",
+	"fremdeln": "eln",
+	"fremdgehen": "en",
+	"frequentieren": "en",
+	"fressen": "en",
+	"freuen": "en",
+	"freunden": "en",
+	"freveln": "eln",
+	"frieren": "en",
+	"frischen": "en",
+	"frisieren": "en",
+	"fristen": "en",
+	"fritieren": "en",
+	"frittieren": "en",
+	"frotzeln": "eln",
+	"fruchten": "en",
+	"frusten": "en",
+	"frustieren": "en",
+	"frustrieren": "en",
+	"fräsen": "en",
+	"frösteln": "eln",
+	"frühstücken": "en",
+	"fuchteln": "eln",
+	"fugen": "en",
+	"fummeln": "eln",
+	"fundieren": "en",
+	"fungieren": "en",
+	"funkeln": "eln",
+	"funken": "en",
+	"funktionalisieren": "en",
+	"funktionieren": "en",
+	"furnieren": "en",
+	"furzen": "en",
+	"fusionieren": "en",
+	"fusseln": "eln",
+	"fussen": "en",
+	"futtern": "ern",
+	"fächeln": "eln",
+	"fächern": "ern",
+	"fädeln": "eln",
+	"fällen": "en",
+	"fälschen": "en",
+	"färben": "en",
+	"föderalisieren": "en",
+	"föderieren": "en",
+	"föhnen": "en",
+	"fönen": "en",
+	"fördern": "ern",
+	"fügen": "en",
+	"fühlen": "en",
+	"führen": "en",
+	"füllen": "en",
+	"fürchten": "en",
+	"füttern": "ern",
+	"gabeln": "eln",
+	"gaffen": "en",
+	"galoppieren": "en",
+	"gammeln": "eln",
+	"garantieren": "en",
+	"garen": "en",
+	"garkochen": "en",
+	"garnieren": "en",
+	"gasen": "en",
+	"gastieren": "en",
+	"gaukeln": "eln",
+	"gaunern": "ern",
+	"geben": "en",
+	"gebieten": "en",
+	"gebrauchen": "en",
+	"gebrechen": "en",
+	"gebärden": "en",
+	"gebären": "en",
+	"gebühren": "en",
+	"gedeihen": "en",
+	"gedenken": "en",
+	"gedulden": "en",
+	"gefallen": "en",
+	"gefangenhalten": "en",
+	"gefangennehmen": "en",
+	"gefrieren": "en",
+	"gefährden": "en",
+	"gegenbuchen": "en",
+	"gegeneinanderhalten": "en",
+	"gegenlenken": "en",
+	"gegenlesen": "en",
+	"gegenrechnen": "en",
+	"gegensteuern": "ern",
+	"gegenzeichnen": "en",
+	"gegenübersehen": "en",
+	"gegenübersitzen": "en",
+	"gegenüberstehen": "en",
+	"gegenüberstellen": "en",
+	"gegenübertreten": "en",
+	"geheimhalten": "en",
+	"gehen": "en",
+	"gehorchen": "en",
+	"gehren": "en",
+	"gehören": "en",
+	"geigen": "en",
+	"geilen": "en",
+	"geisseln": "eln",
+	"geistern": "ern",
+	"geizen": "en",
+	"gelangen": "en",
+	"geleiten": "en",
+	"gelingen": "en",
+	"geloben": "en",
+	"gelten": "en",
+	"gelüsten": "en",
+	"gemahnen": "en",
+	"gemeinden": "en",
+	"genehmigen": "en",
+	"generalisieren": "en",
+	"generieren": "en",
+	"genesen": "en",
+	"genieren": "en",
+	"geniessen": "en",
+	"genügen": "en",
+	"geradebiegen": "en",
+	"geraderichten": "en",
+	"geraderücken": "en",
+	"geradesetzen": "en",
+	"geradesitzen": "en",
+	"geraten": "en",
+	"gerben": "en",
+	"gereichen": "en",
+	"gerieren": "en",
+	"geringachten": "en",
+	"geringschätzen": "en",
+	"gerinnen": "en",
+	"germanisieren": "en",
+	"geschehen": "en",
+	"gesellen": "en",
+	"gestalten": "en",
+	"gestatten": "en",
+	"gestehen": "en",
+	"gestikulieren": "en",
+	"gesundbeten": "en",
+	"gesunden": "en",
+	"gesundpflegen": "en",
+	"gesundschreiben": "en",
+	"gesundschrumpfen": "en",
+	"gesundstossen": "en",
+	"getrauen": "en",
+	"gewachsen": "en",
+	"gewahren": "en",
+	"gewichten": "en",
+	"gewinnen": "en",
+	"gewittern": "ern",
+	"gewähren": "en",
+	"gewährleisten": "en",
+	"gewöhnen": "en",
+	"geziemen": "en",
+	"gieren": "en",
+	"giessen": "en",
+	"giften": "en",
+	"gipfeln": "eln",
+	"gipsen": "en",
+	"gittern": "ern",
+	"glasen": "en",
+	"glasieren": "en",
+	"glattgehen": "en",
+	"glatthobeln": "eln",
+	"glattstellen": "en",
+	"glattstreichen": "en",
+	"glattziehen": "en",
+	"glauben": "en",
+	"gleichbehandeln": "eln",
+	"gleichen": "en",
+	"gleichkommen": "en",
+	"gleichlaufen": "en",
+	"gleichmachen": "en",
+	"gleichschalten": "en",
+	"gleichsetzen": "en",
+	"gleichstehen": "en",
+	"gleichstellen": "en",
+	"gleichstimmen": "en",
+	"gleichziehen": "en",
+	"gleisen": "en",
+	"gleiten": "en",
+	"glibbern": "ern",
+	"gliedern": "ern",
+	"glimmen": "en",
+	"glimmern": "ern",
+	"glitschen": "en",
+	"glorifizieren": "en",
+	"glotzen": "en",
+	"glucksen": "en",
+	"glupschen": "en",
+	"glänzen": "en",
+	"glätten": "en",
+	"glücken": "en",
+	"glühen": "en",
+	"golfen": "en",
+	"gondeln": "eln",
+	"gongen": "en",
+	"goutieren": "en",
+	"graben": "en",
+	"grabschen": "en",
+	"gradieren": "en",
+	"graduieren": "en",
+	"grasen": "en",
+	"grassieren": "en",
+	"gratifizieren": "en",
+	"gratinieren": "en",
+	"gratulieren": "en",
+	"graulen": "en",
+	"graupeln": "eln",
+	"grausen": "en",
+	"greifen": "en",
+	"grenzen": "en",
+	"grillen": "en",
+	"grinsen": "en",
+	"grollen": "en",
+	"grossziehen": "en",
+	"grummeln": "eln",
+	"grunzen": "en",
+	"gruppieren": "en",
+	"gruseln": "eln",
+	"grämen": "en",
+	"grätschen": "en",
+	"grölen": "en",
+	"grübeln": "eln",
+	"gründen": "en",
+	"grünen": "en",
+	"grüssen": "en",
+	"gucken": "en",
+	"gummieren": "en",
+	"gurgeln": "eln",
+	"gurren": "en",
+	"gurten": "en",
+	"gutgehen": "en",
+	"gutheissen": "en",
+	"guttun": "n",
+	"gähnen": "en",
+	"gängeln": "eln",
+	"gären": "en",
+	"gärtnern": "ern",
+	"gönnen": "en",
+	"haaren": "en",
+	"haben": "en",
+	"habilitieren": "en",
+	"hacken": "en",
+	"hadern": "ern",
+	"haften": "en",
+	"haftenbleiben": "en",
+	"hageln": "eln",
+	"haken": "en",
+	"halbieren": "en",
+	"halftern": "ern",
+	"hallen": "en",
+	"halluzinieren": "en",
+	"halsen": "en",
+	"halten": "en",
+	"haltmachen": "en",
+	"hampeln": "eln",
+	"hamstern": "ern",
+	"handeln": "eln",
+	"handhaben": "en",
+	"hangeln": "eln",
+	"hantieren": "en",
+	"hapern": "ern",
+	"harmonieren": "en",
+	"harmonisieren": "en",
+	"harpunieren": "en",
+	"harren": "en",
+	"hartlöten": "en",
+	"harzen": "en",
+	"haschen": "en",
+	"haspeln": "eln",
+	"hassen": "en",
+	"hasten": "en",
+	"hauchen": "en",
+	"hauen": "en",
+	"hausen": "en",
+	"hausieren": "en",
+	"havarieren": "en",
+	"hebeln": "eln",
+	"heben": "en",
+	"hecheln": "eln",
+	"hechteen": "en",
+	"hechten": "en",
+	"heften": "en",
+	"hegen": "en",
+	"heilen": "en",
+	"heiligen": "en",
+	"heiligsprechen": "en",
+	"heimbegeben": "en",
+	"heimbegleiten": "en",
+	"heimbringen": "en",
+	"heimeln": "eln",
+	"heimfallen": "en",
+	"heimfinden": "en",
+	"heimführen": "en",
+	"heimgeigen": "en",
+	"heimholen": "en",
+	"heimkehren": "en",
+	"heimkommen": "en",
+	"heimleuchten": "en",
+	"heimnehmen": "en",
+	"heimreisen": "en",
+	"heimsen": "en",
+	"heimsuchen": "en",
+	"heimtragen": "en",
+	"heimzahlen": "en",
+	"heiraten": "en",
+	"heissen": "en",
+	"heitern": "ern",
+	"heizen": "en",
+	"helfen": "en",
+	"hellen": "en",
+	"hemmen": "en",
+	"henken": "en",
+	"herabfliessen": "en",
+	"herablassen": "en",
+	"herabregnen": "en",
+	"herabreissen": "en",
+	"herabschieben": "en",
+	"herabschiessen": "en",
+	"herabsetzen": "en",
+	"herabsinken": "en",
+	"herabstufen": "en",
+	"heranführen": "en",
+	"herangehen": "en",
+	"herankarren": "en",
+	"herankommen": "en",
+	"herankriechen": "en",
+	"heranmachen": "en",
+	"heranreifen": "en",
+	"heranrücken": "en",
+	"heranschaukeln": "eln",
+	"heranschleichen": "en",
+	"heranschmeissen": "en",
+	"heranspielen": "en",
+	"herantrauen": "en",
+	"heranwachsen": "en",
+	"heranziehen": "en",
+	"heranzüchten": "en",
+	"heraufbemühen": "en",
+	"heraufbeschwören": "en",
+	"herauffallen": "en",
+	"herauffliessen": "en",
+	"heraufführen": "en",
+	"heraufreichen": "en",
+	"heraufrollen": "en",
+	"heraufsetzen": "en",
+	"heraufziehen": "en",
+	"herausarbeiten": "en",
+	"herausbeissen": "en",
+	"herausbekommen": "en",
+	"herausbilden": "en",
+	"herausbrechen": "en",
+	"herausbringen": "en",
+	"herausdringen": "en",
+	"herausfallen": "en",
+	"herausfiltern": "ern",
+	"herausfinden": "en",
+	"herausfordern": "ern",
+	"herausführen": "en",
+	"herausgeben": "en",
+	"herausgehen": "en",
+	"herausgreifen": "en",
+	"heraushalten": "en",
+	"herausheben": "en",
+	"herausholen": "en",
+	"herauskommen": "en",
+	"herauskramen": "en",
+	"herauslugen": "en",
+	"herauslösen": "en",
+	"herausnehmen": "en",
+	"herauspicken": "en",
+	"herausputzen": "en",
+	"herausquetschen": "en",
+	"herausreden": "en",
+	"herausreichen": "en",
+	"herausrücken": "en",
+	"herausschiessen": "en",
+	"herausschinden": "en",
+	"herausschleudern": "ern",
+	"herausschliessen": "en",
+	"herausspielen": "en",
+	"heraussprengen": "en",
+	"herausspriessen": "en",
+	"herausspringen": "en",
+	"herausspritzen": "en",
+	"heraussprudeln": "eln",
+	"herausstellen": "en",
+	"herausstreichen": "en",
+	"heraussuchen": "en",
+	"herauswerfen": "en",
+	"herauswinden": "en",
+	"herbegleiten": "en",
+	"herbeibringen": "en",
+	"herbeidrängen": "en",
+	"herbeieilen": "en",
+	"herbeifliegen": "en",
+	"herbeiführen": "en",
+	"herbeiholen": "en",
+	"herbeilaufen": "en",
+	"herbeireformieren": "en",
+	"herbeirennen": "en",
+	"herbeirufen": "en",
+	"herbeischaffen": "en",
+	"herbeischleppen": "en",
+	"herbeischwören": "en",
+	"herbeisehnen": "en",
+	"herbeispringen": "en",
+	"herbeiströmen": "en",
+	"herbeistürzen": "en",
+	"herbeitragen": "en",
+	"herbeiwinken": "en",
+	"herbeiwünschen": "en",
+	"herbeizitieren": "en",
+	"herbekommen": "en",
+	"herbemühen": "en",
+	"herbeordern": "ern",
+	"herbequemen": "en",
+	"herbestellen": "en",
+	"herbeten": "en",
+	"herbitten": "en",
+	"herbringen": "en",
+	"herdenken": "en",
+	"hereinbitten": "en",
+	"hereinbrechen": "en",
+	"hereinfallen": "en",
+	"hereinholen": "en",
+	"hereinkommen": "en",
+	"hereinlassen": "en",
+	"hereinreichen": "en",
+	"hereinrufen": "en",
+	"hereinströmen": "en",
+	"herfallen": "en",
+	"herfinden": "en",
+	"herfliegen": "en",
+	"herführen": "en",
+	"hergeben": "en",
+	"herhalten": "en",
+	"herhinken": "en",
+	"herholen": "en",
+	"herhören": "en",
+	"herirren": "en",
+	"herjagen": "en",
+	"herkommen": "en",
+	"herkriegen": "en",
+	"herlangen": "en",
+	"herlaufen": "en",
+	"herlegen": "en",
+	"herleiern": "ern",
+	"herleihen": "en",
+	"herleiten": "en",
+	"hermachen": "en",
+	"hernehmen": "en",
+	"herniedersenken": "en",
+	"heroisieren": "en",
+	"herpendeln": "eln",
+	"herreichen": "en",
+	"herrichten": "en",
+	"herrschen": "en",
+	"herrufen": "en",
+	"herrücken": "en",
+	"herrühren": "en",
+	"hersagen": "en",
+	"herschaffen": "en",
+	"herschauen": "en",
+	"herschieben": "en",
+	"herschleichen": "en",
+	"herschleppen": "en",
+	"hersehen": "en",
+	"hersehnen": "en",
+	"hersetzen": "en",
+	"herspringen": "en",
+	"herstammen": "en",
+	"herstellen": "en",
+	"hertragen": "en",
+	"hertreiben": "en",
+	"herumbringen": "en",
+	"herumdoktern": "ern",
+	"herumdrehen": "en",
+	"herumfahren": "en",
+	"herumfliegen": "en",
+	"herumführen": "en",
+	"herumgehen": "en",
+	"herumhängen": "en",
+	"herumkommen": "en",
+	"herumlaufen": "en",
+	"herumliegen": "en",
+	"herumlungern": "ern",
+	"herummäkeln": "eln",
+	"herumreichen": "en",
+	"herumreisen": "en",
+	"herumrühren": "en",
+	"herumschlagen": "en",
+	"herumsingen": "en",
+	"herumspekulieren": "en",
+	"herumsprechen": "en",
+	"herumspritzen": "en",
+	"herumstehen": "en",
+	"herumstreichen": "en",
+	"herumsuchen": "en",
+	"herumtanzen": "en",
+	"herumtragen": "en",
+	"herumtrampeln": "eln",
+	"herumtönen": "en",
+	"herumwirbeln": "eln",
+	"herumwuseln": "eln",
+	"herumziehen": "en",
+	"herunterbemühen": "en",
+	"herunterbringen": "en",
+	"herunterdrücken": "en",
+	"herunterfallen": "en",
+	"heruntergehen": "en",
+	"herunterkommen": "en",
+	"herunterkopieren": "en",
+	"herunterleiern": "ern",
+	"heruntermachen": "en",
+	"herunternehmen": "en",
+	"herunterrasseln": "eln",
+	"herunterreichen": "en",
+	"herunterreissen": "en",
+	"herunterrennen": "en",
+	"herunterrepräsentieren": "en",
+	"herunterrieseln": "eln",
+	"herunterrollen": "en",
+	"herunterschieben": "en",
+	"herunterschiessen": "en",
+	"heruntersetzen": "en",
+	"herunterspielen": "en",
+	"herunterspulen": "en",
+	"heruntertragen": "en",
+	"hervorbrechen": "en",
+	"hervorbringen": "en",
+	"hervordringen": "en",
+	"hervordrängen": "en",
+	"hervorgegangen": "en",
+	"hervorgehen": "en",
+	"hervorheben": "en",
+	"hervorkehren": "en",
+	"hervorkeimen": "en",
+	"hervorkommen": "en",
+	"hervorkramen": "en",
+	"hervorleuchten": "en",
+	"hervorquellen": "en",
+	"hervorragen": "en",
+	"hervorrufen": "en",
+	"hervorschauen": "en",
+	"hervorschiessen": "en",
+	"hervorschimmern": "ern",
+	"hervorspringen": "en",
+	"hervorsprudeln": "eln",
+	"hervorstechen": "en",
+	"hervorstehen": "en",
+	"hervorstossen": "en",
+	"hervorstürzen": "en",
+	"hervorsuchen": "en",
+	"hervortauchen": "en",
+	"hervortreten": "en",
+	"hervorwagen": "en",
+	"hervorzaubern": "ern",
+	"herwerfen": "en",
+	"herwinken": "en",
+	"herzaubern": "ern",
+	"herzeigen": "en",
+	"herzen": "en",
+	"herziehen": "en",
+	"herzueilen": "en",
+	"herzählen": "en",
+	"herüberbemühen": "en",
+	"herüberdringen": "en",
+	"herüberführen": "en",
+	"herüberlangen": "en",
+	"herüberreichen": "en",
+	"herüberretten": "en",
+	"herüberrücken": "en",
+	"herübertragen": "en",
+	"herüberziehen": "en",
+	"hetzen": "en",
+	"heucheln": "eln",
+	"heuern": "ern",
+	"heulen": "en",
+	"hexen": "en",
+	"hierbehalten": "en",
+	"hierbleiben": "en",
+	"hierherbemühen": "en",
+	"hierherbitten": "en",
+	"hierherbringen": "en",
+	"hierhereilen": "en",
+	"hierherführen": "en",
+	"hierhergehören": "en",
+	"hierherholen": "en",
+	"hierherkommen": "en",
+	"hierherlegen": "en",
+	"hierherreisen": "en",
+	"hierherschaffen": "en",
+	"hierherschauen": "en",
+	"hierherschieben": "en",
+	"hierherschleppen": "en",
+	"hierhersetzen": "en",
+	"hierherstellen": "en",
+	"hierhertragen": "en",
+	"hierhertreiben": "en",
+	"hierherwagen": "en",
+	"hieven": "en",
+	"himmeln": "eln",
+	"hinabschreiten": "en",
+	"hinabsehen": "en",
+	"hinabsinken": "en",
+	"hinarbeiten": "en",
+	"hinaufbemühen": "en",
+	"hinaufführen": "en",
+	"hinaufreichen": "en",
+	"hinaufschaffen": "en",
+	"hinaufschrauben": "en",
+	"hinaufstellen": "en",
+	"hinaufwinden": "en",
+	"hinaufziehen": "en",
+	"hinausgehen": "en",
+	"hinausgreifen": "en",
+	"hinauskommen": "en",
+	"hinauslaufen": "en",
+	"hinausreichen": "en",
+	"hinausschauen": "en",
+	"hinausschieben": "en",
+	"hinausschiessen": "en",
+	"hinausschreien": "en",
+	"hinausstürzen": "en",
+	"hinauszögern": "ern",
+	"hinbegleiten": "en",
+	"hinbekommen": "en",
+	"hinbemühen": "en",
+	"hinbestellen": "en",
+	"hinbiegen": "en",
+	"hinblättern": "ern",
+	"hinbringen": "en",
+	"hinbrüten": "en",
+	"hindenken": "en",
+	"hindern": "ern",
+	"hindeuten": "en",
+	"hindrängen": "en",
+	"hindurcharbeiten": "en",
+	"hindurchdringen": "en",
+	"hindurchfliessen": "en",
+	"hindurchkriechen": "en",
+	"hindurchziehen": "en",
+	"hindurchzwängen": "en",
+	"hindämmern": "ern",
+	"hineilen": "en",
+	"hineinbemühen": "en",
+	"hineinbohren": "en",
+	"hineindrängen": "en",
+	"hineindürfen": "en",
+	"hineinfressen": "en",
+	"hineinführen": "en",
+	"hineinhängen": "en",
+	"hineinlesen": "en",
+	"hineinmanövrieren": "en",
+	"hineinpassen": "en",
+	"hineinprojizieren": "en",
+	"hineinreichen": "en",
+	"hineinreiten": "en",
+	"hineinschauen": "en",
+	"hineinschlingen": "en",
+	"hineinschreiben": "en",
+	"hineinstopfen": "en",
+	"hineinstürzen": "en",
+	"hineinziehen": "en",
+	"hinfahren": "en",
+	"hinfallen": "en",
+	"hinfinden": "en",
+	"hinflegeln": "eln",
+	"hinfliegen": "en",
+	"hinfliessen": "en",
+	"hinfläzen": "en",
+	"hinführen": "en",
+	"hingeben": "en",
+	"hingehen": "en",
+	"hingehören": "en",
+	"hingeraten": "en",
+	"hingiessen": "en",
+	"hinhalten": "en",
+	"hinhauchen": "en",
+	"hinhauen": "en",
+	"hinhorchen": "en",
+	"hinhängen": "en",
+	"hinhören": "en",
+	"hinkauern": "ern",
+	"hinken": "en",
+	"hinklotzen": "en",
+	"hinknien": "en",
+	"hinkommen": "en",
+	"hinkriegen": "en",
+	"hinlangen": "en",
+	"hinlassen": "en",
+	"hinlaufen": "en",
+	"hinlegen": "en",
+	"hinlümmeln": "eln",
+	"hinmachen": "en",
+	"hinnehmen": "en",
+	"hinneigen": "en",
+	"hinpurzeln": "eln",
+	"hinraffen": "en",
+	"hinreiben": "en",
+	"hinreichen": "en",
+	"hinreisen": "en",
+	"hinreissen": "en",
+	"hinrennen": "en",
+	"hinrichten": "en",
+	"hinsagen": "en",
+	"hinschaffen": "en",
+	"hinschauen": "en",
+	"hinscheiden": "en",
+	"hinschieben": "en",
+	"hinschlachten": "en",
+	"hinschlagen": "en",
+	"hinschleichen": "en",
+	"hinschleppen": "en",
+	"hinschmeissen": "en",
+	"hinschmelzen": "en",
+	"hinschmieren": "en",
+	"hinschreiben": "en",
+	"hinschwimmen": "en",
+	"hinschwinden": "en",
+	"hinschütten": "en",
+	"hinsegeln": "eln",
+	"hinsehen": "en",
+	"hinsetzen": "en",
+	"hinsiechen": "en",
+	"hinsinken": "en",
+	"hinstellen": "en",
+	"hinsterben": "en",
+	"hinsteuern": "ern",
+	"hinstreben": "en",
+	"hinstrukturieren": "en",
+	"hinströmen": "en",
+	"hinstürzen": "en",
+	"hintanstellen": "en",
+	"hintenanstellen": "en",
+	"hintenüberfallen": "en",
+	"hintenüberkippen": "en",
+	"hinteressen": "en",
+	"hinterfragen": "en",
+	"hintergehen": "en",
+	"hintergiessen": "en",
+	"hinterhaken": "en",
+	"hinterherdenken": "en",
+	"hinterherfahren": "en",
+	"hinterherfragen": "en",
+	"hinterherhinken": "en",
+	"hinterherlaufen": "en",
+	"hinterherräumen": "en",
+	"hinterhersenden": "en",
+	"hinterherspringen": "en",
+	"hinterherwerfen": "en",
+	"hinterkauen": "en",
+	"hinterlassen": "en",
+	"hinterlegen": "en",
+	"hinterziehen": "en",
+	"hintragen": "en",
+	"hintreiben": "en",
+	"hintreten": "en",
+	"hinunterbemühen": "en",
+	"hinunterfliessen": "en",
+	"hinunterführen": "en",
+	"hinuntergelangen": "en",
+	"hinunterstürzen": "en",
+	"hinunterziehen": "en",
+	"hinweggehen": "en",
+	"hinweghelfen": "en",
+	"hinwegkommen": "en",
+	"hinwegraffen": "en",
+	"hinwegschleppen": "en",
+	"hinwegsehen": "en",
+	"hinwegsetzen": "en",
+	"hinwegsprengen": "en",
+	"hinwegtrösten": "en",
+	"hinwegtäuschen": "en",
+	"hinweisen": "en",
+	"hinwelken": "en",
+	"hinwenden": "en",
+	"hinwerfen": "en",
+	"hinwirken": "en",
+	"hinzaubern": "ern",
+	"hinzeigen": "en",
+	"hinziehen": "en",
+	"hinzudenken": "en",
+	"hinzufügen": "en",
+	"hinzugesellen": "en",
+	"hinzugewinnen": "en",
+	"hinzukommen": "en",
+	"hinzulernen": "en",
+	"hinzurechnen": "en",
+	"hinzusetzen": "en",
+	"hinzustellen": "en",
+	"hinzutreten": "en",
+	"hinzuziehen": "en",
+	"hinzuzählen": "en",
+	"hinzählen": "en",
+	"hinüberbemühen": "en",
+	"hinüberführen": "en",
+	"hinüberlappen": "en",
+	"hinüberreichen": "en",
+	"hinüberretten": "en",
+	"hinüberrücken": "en",
+	"hinüberschauen": "en",
+	"hinüberschwappen": "en",
+	"hinüberziehen": "en",
+	"hissen": "en",
+	"hobeln": "eln",
+	"hochachten": "en",
+	"hocharbeiten": "en",
+	"hochbegehren": "en",
+	"hochbinden": "en",
+	"hochbringen": "en",
+	"hochdienen": "en",
+	"hochdrücken": "en",
+	"hochfahren": "en",
+	"hochgehen": "en",
+	"hochheben": "en",
+	"hochjagen": "en",
+	"hochjubeln": "eln",
+	"hochklappen": "en",
+	"hochkämmen": "en",
+	"hochleben": "en",
+	"hochnehmen": "en",
+	"hochpäppeln": "eln",
+	"hochrechnen": "en",
+	"hochreissen": "en",
+	"hochrennen": "en",
+	"hochschaukeln": "eln",
+	"hochschnellen": "en",
+	"hochschrauben": "en",
+	"hochschrecken": "en",
+	"hochschätzen": "en",
+	"hochsehen": "en",
+	"hochsetzen": "en",
+	"hochspielen": "en",
+	"hochspringen": "en",
+	"hochspülen": "en",
+	"hochstapeln": "eln",
+	"hochsteigen": "en",
+	"hochstellen": "en",
+	"hochstilisieren": "en",
+	"hochstreben": "en",
+	"hochtragen": "en",
+	"hochtreiben": "en",
+	"hochziehen": "en",
+	"hochzüchten": "en",
+	"hocken": "en",
+	"hoffen": "en",
+	"hofieren": "en",
+	"holen": "en",
+	"holpern": "ern",
+	"holzen": "en",
+	"homogenisieren": "en",
+	"honorieren": "en",
+	"hoppeln": "eln",
+	"hoppen": "en",
+	"hopsen": "en",
+	"hopsgehen": "en",
+	"hopsnehmen": "en",
+	"horchen": "en",
+	"horten": "en",
+	"hospitieren": "en",
+	"huldigen": "en",
+	"humanisieren": "en",
+	"humifizieren": "en",
+	"hungern": "ern",
+	"hupen": "en",
+	"huschen": "en",
+	"husten": "en",
+	"hydrieren": "en",
+	"hypnotisieren": "en",
+	"häckseln": "eln",
+	"häkeln": "eln",
+	"hämmern": "ern",
+	"händigen": "en",
+	"hängen": "en",
+	"hängenbleiben": "en",
+	"hänseln": "eln",
+	"härten": "en",
+	"hätscheln": "eln",
+	"häufeln": "eln",
+	"häufen": "en",
+	"häuten": "en",
+	"höherklettern": "ern",
+	"höhlen": "en",
+	"höhnen": "en",
+	"hökern": "ern",
+	"hören": "en",
+	"hüllen": "en",
+	"hülsen": "en",
+	"hüpfen": "en",
+	"hüten": "en",
+	"idealisieren": "en",
+	"identifizieren": "en",
+	"ideologisieren": "en",
+	"idiomatisieren": "en",
+	"igeln": "eln",
+	"ignorieren": "en",
+	"illuminieren": "en",
+	"illustrieren": "en",
+	"imitieren": "en",
+	"imkern": "ern",
+	"immunisieren": "en",
+	"impfen": "en",
+	"implizieren": "en",
+	"imponieren": "en",
+	"importieren": "en",
+	"improvisieren": "en",
+	"indexieren": "en",
+	"individualisieren": "en",
+	"indizieren": "en",
+	"industrialisieren": "en",
+	"induzieren": "en",
+	"ineinanderfügen": "en",
+	"ineinandergefügen": "en",
+	"ineinandergreifen": "en",
+	"infiltrieren": "en",
+	"infizieren": "en",
+	"informieren": "en",
+	"infragestellen": "en",
+	"inhaftieren": "en",
+	"inhalieren": "en",
+	"initiieren": "en",
+	"injizieren": "en",
+	"inkrafttreten": "en",
+	"inkrementieren": "en",
+	"innehaben": "en",
+	"innehalten": "en",
+	"innewerden": "en",
+	"innewohnen": "en",
+	"inserieren": "en",
+	"insistieren": "en",
+	"inspirieren": "en",
+	"inspizieren": "en",
+	"installieren": "en",
+	"instruieren": "en",
+	"instrumentalisieren": "en",
+	"instrumentieren": "en",
+	"inszenieren": "en",
+	"integrieren": "en",
+	"intendieren": "en",
+	"intensivieren": "en",
+	"interessieren": "en",
+	"internalisieren": "en",
+	"internationalisieren": "en",
+	"internieren": "en",
+	"interpolieren": "en",
+	"interpretieren": "en",
+	"interpunktieren": "en",
+	"intervenieren": "en",
+	"interviewen": "en",
+	"inthronisieren": "en",
+	"intonieren": "en",
+	"intrigieren": "en",
+	"investieren": "en",
+	"involvieren": "en",
+	"ionisieren": "en",
+	"ironisieren": "en",
+	"irreführen": "en",
+	"irreleiten": "en",
+	"irren": "en",
+	"irritieren": "en",
+	"irrlichtern": "ern",
+	"islamisieren": "en",
+	"isolieren": "en",
+	"jagen": "en",
+	"jammern": "ern",
+	"japsen": "en",
+	"jauchzen": "en",
+	"jaulen": "en",
+	"jehen": "en",
+	"jetten": "en",
+	"jobben": "en",
+	"jodeln": "eln",
+	"johlen": "en",
+	"jubeln": "eln",
+	"jubilieren": "en",
+	"juchzen": "en",
+	"jurieren": "en",
+	"justieren": "en",
+	"justifizieren": "en",
+	"jähren": "en",
+	"jäten": "en",
+	"kacheln": "eln",
+	"kahlfressen": "en",
+	"kahlscheren": "en",
+	"kakeln": "eln",
+	"kalben": "en",
+	"kalken": "en",
+	"kalkulieren": "en",
+	"kaltstellen": "en",
+	"kampieren": "en",
+	"kanalisieren": "en",
+	"kandidieren": "en",
+	"kandieren": "en",
+	"kanonieren": "en",
+	"kanonisieren": "en",
+	"kanten": "en",
+	"kanzeln": "eln",
+	"kapern": "ern",
+	"kapieren": "en",
+	"kapitalisieren": "en",
+	"kapitulieren": "en",
+	"kappen": "en",
+	"kapseln": "eln",
+	"kaputtgehen": "en",
+	"kaputtkriegen": "en",
+	"kaputtlachen": "en",
+	"kaputtreden": "en",
+	"kaputtsparen": "en",
+	"karieren": "en",
+	"karikieren": "en",
+	"karren": "en",
+	"kartellieren": "en",
+	"karten": "en",
+	"kartographieren": "en",
+	"kartonieren": "en",
+	"kaschieren": "en",
+	"kasernieren": "en",
+	"kaspern": "ern",
+	"kassieren": "en",
+	"katalogisieren": "en",
+	"katalysieren": "en",
+	"katapultieren": "en",
+	"kategorisieren": "en",
+	"katholisieren": "en",
+	"kauen": "en",
+	"kauern": "ern",
+	"kaufen": "en",
+	"kegeln": "eln",
+	"kehren": "en",
+	"keifen": "en",
+	"keilen": "en",
+	"keimen": "en",
+	"kellern": "ern",
+	"kellnern": "ern",
+	"keltern": "ern",
+	"kennen": "en",
+	"kennenlernen": "en",
+	"kennzeichnen": "en",
+	"kentern": "ern",
+	"kerben": "en",
+	"kerkern": "ern",
+	"kernen": "en",
+	"kesseln": "eln",
+	"ketten": "en",
+	"keuchen": "en",
+	"kichern": "ern",
+	"kicken": "en",
+	"kieken": "en",
+	"kiffen": "en",
+	"killen": "en",
+	"kippen": "en",
+	"kitten": "en",
+	"kitzeln": "eln",
+	"klabastern": "ern",
+	"klackern": "ern",
+	"klaffen": "en",
+	"klagen": "en",
+	"klammern": "ern",
+	"klamüsern": "ern",
+	"klappen": "en",
+	"klappern": "ern",
+	"klaren": "en",
+	"klarkommen": "en",
+	"klarkriegen": "en",
+	"klarmachen": "en",
+	"klarsehen": "en",
+	"klarstellen": "en",
+	"klarwerden": "en",
+	"klassieren": "en",
+	"klassifizieren": "en",
+	"klatschen": "en",
+	"klauben": "en",
+	"klauen": "en",
+	"kleben": "en",
+	"klebenbleiben": "en",
+	"kleckern": "ern",
+	"klecksen": "en",
+	"kleiden": "en",
+	"kleinkriegen": "en",
+	"kleinreden": "en",
+	"kleinschneiden": "en",
+	"kleistern": "ern",
+	"klemmen": "en",
+	"klempnern": "ern",
+	"klettern": "ern",
+	"klimatisieren": "en",
+	"klimmen": "en",
+	"klimpern": "ern",
+	"klingeln": "eln",
+	"klingen": "en",
+	"klinken": "en",
+	"klirren": "en",
+	"klonen": "en",
+	"klopfen": "en",
+	"kloppen": "en",
+	"klotzen": "en",
+	"klumpen": "en",
+	"kläffen": "en",
+	"klären": "en",
+	"klöhnen": "en",
+	"klönen": "en",
+	"klügeln": "eln",
+	"klüngeln": "eln",
+	"knabbern": "ern",
+	"knacken": "en",
+	"knacksen": "en",
+	"knallen": "en",
+	"knapphalten": "en",
+	"knapsen": "en",
+	"knarren": "en",
+	"knarzen": "en",
+	"knattern": "ern",
+	"knausern": "ern",
+	"knautschen": "en",
+	"knebeln": "eln",
+	"knechten": "en",
+	"kneifen": "en",
+	"kneten": "en",
+	"knicken": "en",
+	"knicksen": "en",
+	"knien": "en",
+	"knipsen": "en",
+	"knirschen": "en",
+	"knistern": "ern",
+	"knittern": "ern",
+	"knobeln": "eln",
+	"knoten": "en",
+	"knuffen": "en",
+	"knurren": "en",
+	"knuspern": "ern",
+	"knutschen": "en",
+	"knöpfen": "en",
+	"knüllen": "en",
+	"knüpfen": "en",
+	"knüppeln": "eln",
+	"koalieren": "en",
+	"kochen": "en",
+	"kohlen": "en",
+	"kokeln": "eln",
+	"kokettieren": "en",
+	"koksen": "en",
+	"kollabieren": "en",
+	"kollaborieren": "en",
+	"kollidieren": "en",
+	"kolonialisieren": "en",
+	"kolonisieren": "en",
+	"kolportieren": "en",
+	"kombinieren": "en",
+	"kommandieren": "en",
+	"kommen": "en",
+	"kommentieren": "en",
+	"kommerzialisieren": "en",
+	"kommunalisieren": "en",
+	"kommunizieren": "en",
+	"komparieren": "en",
+	"kompensieren": "en",
+	"kompilieren": "en",
+	"komplementieren": "en",
+	"komplettieren": "en",
+	"komplimentieren": "en",
+	"komplizieren": "en",
+	"komplottieren": "en",
+	"komponieren": "en",
+	"kompostieren": "en",
+	"komprimieren": "en",
+	"kondensieren": "en",
+	"konditionieren": "en",
+	"kondolieren": "en",
+	"konfektionieren": "en",
+	"konferieren": "en",
+	"konfigurieren": "en",
+	"konfirmieren": "en",
+	"konfiszieren": "en",
+	"konfrontieren": "en",
+	"konföderieren": "en",
+	"kongruieren": "en",
+	"konjugieren": "en",
+	"konkretisieren": "en",
+	"konkurieren": "en",
+	"konkurrieren": "en",
+	"konservieren": "en",
+	"konsolidieren": "en",
+	"konspirieren": "en",
+	"konstatieren": "en",
+	"konsternieren": "en",
+	"konstituieren": "en",
+	"konstruieren": "en",
+	"konsultieren": "en",
+	"konsumieren": "en",
+	"kontakten": "en",
+	"kontaktieren": "en",
+	"kontaminieren": "en",
+	"konterkarieren": "en",
+	"konterkarrieren": "en",
+	"kontern": "ern",
+	"kontrahieren": "en",
+	"kontrastieren": "en",
+	"kontrollieren": "en",
+	"konturieren": "en",
+	"konvertieren": "en",
+	"konzedieren": "en",
+	"konzentrieren": "en",
+	"konzipieren": "en",
+	"kooperieren": "en",
+	"koordinieren": "en",
+	"kopieren": "en",
+	"koppeln": "eln",
+	"korken": "en",
+	"korrelieren": "en",
+	"korrespondieren": "en",
+	"korrigieren": "en",
+	"korrumpieren": "en",
+	"kosen": "en",
+	"kosten": "en",
+	"kostümieren": "en",
+	"kotzen": "en",
+	"krabbeln": "eln",
+	"krachen": "en",
+	"krakeelen": "en",
+	"krallen": "en",
+	"kramen": "en",
+	"krampfen": "en",
+	"kranken": "en",
+	"krankenversicheren": "en",
+	"krankfeiern": "ern",
+	"kranklachen": "en",
+	"krankschreiben": "en",
+	"kratzen": "en",
+	"kraulen": "en",
+	"krausen": "en",
+	"kredenzen": "en",
+	"kreiden": "en",
+	"kreieren": "en",
+	"kreischen": "en",
+	"kreisen": "en",
+	"kreissen": "en",
+	"krempeln": "eln",
+	"krepieren": "en",
+	"kreuzen": "en",
+	"kreuzigen": "en",
+	"kribbeln": "eln",
+	"kriechen": "en",
+	"kriegen": "en",
+	"kriminalisieren": "en",
+	"kringeln": "eln",
+	"kriseln": "eln",
+	"kristallisieren": "en",
+	"kritisieren": "en",
+	"kritzeln": "eln",
+	"krummbiegen": "en",
+	"krummlachen": "en",
+	"krummlegen": "en",
+	"krummnehmen": "en",
+	"krächzen": "en",
+	"kräftigen": "en",
+	"krähen": "en",
+	"kränken": "en",
+	"kränzen": "en",
+	"kräuseln": "eln",
+	"krönen": "en",
+	"krümeln": "eln",
+	"krümmen": "en",
+	"kugeln": "eln",
+	"kultivieren": "en",
+	"kumulieren": "en",
+	"kundgeben": "en",
+	"kundschaften": "en",
+	"kundtun": "n",
+	"kungeln": "eln",
+	"kuppeln": "eln",
+	"kurbeln": "eln",
+	"kuren": "en",
+	"kurieren": "en",
+	"kursieren": "en",
+	"kurven": "en",
+	"kurzarbeiten": "en",
+	"kurzhalten": "en",
+	"kurzschliessen": "en",
+	"kuscheln": "eln",
+	"kuschen": "en",
+	"kutschieren": "en",
+	"kämmen": "en",
+	"kämpfen": "en",
+	"käuen": "en",
+	"ködern": "ern",
+	"können": "en",
+	"köpfen": "en",
+	"kübeln": "eln",
+	"kühlen": "en",
+	"kümmern": "ern",
+	"künden": "en",
+	"kündigen": "en",
+	"küren": "en",
+	"kürzen": "en",
+	"küssen": "en",
+	"labbern": "ern",
+	"laben": "en",
+	"labern": "ern",
+	"laborieren": "en",
+	"lachen": "en",
+	"lackieren": "en",
+	"laden": "en",
+	"lagern": "ern",
+	"lahmen": "en",
+	"lahmlegen": "en",
+	"laktieren": "en",
+	"lallen": "en",
+	"lamentieren": "en",
+	"laminieren": "en",
+	"lancieren": "en",
+	"landen": "en",
+	"langdrehen": "en",
+	"langen": "en",
+	"langgehen": "en",
+	"langweilen": "en",
+	"lappen": "en",
+	"lassen": "en",
+	"lasten": "en",
+	"latschen": "en",
+	"lauern": "ern",
+	"laufen": "en",
+	"laugen": "en",
+	"lauschen": "en",
+	"lausen": "en",
+	"lauten": "en",
+	"lavieren": "en",
+	"leben": "en",
+	"lecken": "en",
+	"leckschlagen": "en",
+	"ledern": "ern",
+	"leeren": "en",
+	"leerfegen": "en",
+	"leerfeuern": "ern",
+	"leermachen": "en",
+	"leerstehen": "en",
+	"legalisieren": "en",
+	"legen": "en",
+	"legitimieren": "en",
+	"lehnen": "en",
+	"lehren": "en",
+	"leiben": "en",
+	"leiden": "en",
+	"leiern": "ern",
+	"leihen": "en",
+	"leimen": "en",
+	"leinen": "en",
+	"leisten": "en",
+	"leiten": "en",
+	"lemmatisieren": "en",
+	"lenken": "en",
+	"lernen": "en",
+	"lesen": "en",
+	"leuchten": "en",
+	"leugnen": "en",
+	"lexikalisieren": "en",
+	"liberalisieren": "en",
+	"lichten": "en",
+	"liebbehalten": "en",
+	"lieben": "en",
+	"liebgewinnen": "en",
+	"liebkosen": "en",
+	"liebäugeln": "eln",
+	"liefern": "ern",
+	"liegen": "en",
+	"liegenbleiben": "en",
+	"liften": "en",
+	"limitieren": "en",
+	"lindern": "ern",
+	"linieren": "en",
+	"liquidieren": "en",
+	"lispeln": "eln",
+	"listen": "en",
+	"literalisieren": "en",
+	"literarisieren": "en",
+	"loben": "en",
+	"lobpreisen": "en",
+	"lochen": "en",
+	"locken": "en",
+	"lockerlassen": "en",
+	"lockermachen": "en",
+	"lockern": "ern",
+	"lodern": "ern",
+	"loggen": "en",
+	"logieren": "en",
+	"lohnen": "en",
+	"lokalisieren": "en",
+	"lombardieren": "en",
+	"losarbeiten": "en",
+	"losbekommen": "en",
+	"losbinden": "en",
+	"losbrausen": "en",
+	"losbrechen": "en",
+	"losbringen": "en",
+	"losdonnern": "ern",
+	"loseisen": "en",
+	"losen": "en",
+	"losgeben": "en",
+	"losgehen": "en",
+	"loshauchen": "en",
+	"loshauen": "en",
+	"loskaufen": "en",
+	"losketten": "en",
+	"loskommen": "en",
+	"loskoppeln": "eln",
+	"loslachen": "en",
+	"loslassen": "en",
+	"loslaufen": "en",
+	"loslegen": "en",
+	"loslösen": "en",
+	"losmachen": "en",
+	"losmarschieren": "en",
+	"losplatzen": "en",
+	"losrasen": "en",
+	"losreissen": "en",
+	"losrennen": "en",
+	"lossagen": "en",
+	"losschicken": "en",
+	"losschiessen": "en",
+	"losschlagen": "en",
+	"losschrauben": "en",
+	"lossprechen": "en",
+	"losspringen": "en",
+	"lossteuern": "ern",
+	"losstürmen": "en",
+	"lostrennen": "en",
+	"lostreten": "en",
+	"loswerden": "en",
+	"losziehen": "en",
+	"loten": "en",
+	"lotsen": "en",
+	"lottern": "ern",
+	"lugen": "en",
+	"lullen": "en",
+	"lungern": "ern",
+	"lupfen": "en",
+	"lustwandeln": "eln",
+	"lutschen": "en",
+	"lynchen": "en",
+	"lächeln": "eln",
+	"lädieren": "en",
+	"lähmen": "en",
+	"läppern": "ern",
+	"lärmen": "en",
+	"lästern": "ern",
+	"läuten": "en",
+	"löchern": "ern",
+	"löffeln": "eln",
+	"löhnen": "en",
+	"löschen": "en",
+	"lösen": "en",
+	"löten": "en",
+	"lüften": "en",
+	"lügen": "en",
+	"lümmeln": "eln",
+	"machen": "en",
+	"magern": "ern",
+	"mahlen": "en",
+	"mahnen": "en",
+	"malen": "en",
+	"malnehmen": "en",
+	"mampfen": "en",
+	"managen": "en",
+	"mangeln": "eln",
+	"manifestieren": "en",
+	"manipulieren": "en",
+	"manövrieren": "en",
+	"marginalisieren": "en",
+	"marinieren": "en",
+	"markieren": "en",
+	"marmorieren": "en",
+	"marschieren": "en",
+	"martern": "ern",
+	"masern": "ern",
+	"maskieren": "en",
+	"massen": "en",
+	"masshalten": "en",
+	"massieren": "en",
+	"masturbieren": "en",
+	"materialisieren": "en",
+	"mathematisieren": "en",
+	"mauern": "ern",
+	"maulen": "en",
+	"mauscheln": "eln",
+	"mausern": "ern",
+	"maximieren": "en",
+	"meckern": "ern",
+	"meditieren": "en",
+	"mehren": "en",
+	"meiden": "en",
+	"meinen": "en",
+	"meisseln": "eln",
+	"meistern": "ern",
+	"melden": "en",
+	"melken": "en",
+	"memorieren": "en",
+	"mengen": "en",
+	"merken": "en",
+	"merzen": "en",
+	"messen": "en",
+	"metallisieren": "en",
+	"methodisieren": "en",
+	"metzeln": "eln",
+	"meutern": "ern",
+	"miefen": "en",
+	"mieten": "en",
+	"mildern": "ern",
+	"militarisieren": "en",
+	"mimen": "en",
+	"mindern": "ern",
+	"miniaturisieren": "en",
+	"minimalisieren": "en",
+	"minimieren": "en",
+	"mischen": "en",
+	"missachten": "en",
+	"missbilden": "en",
+	"missbilligen": "en",
+	"missbrauchen": "en",
+	"missdeuten": "en",
+	"missen": "en",
+	"missfallen": "en",
+	"missglücken": "en",
+	"missgönnen": "en",
+	"misshandeln": "eln",
+	"missinterpretieren": "en",
+	"missionieren": "en",
+	"misslingen": "en",
+	"missraten": "en",
+	"misstrauen": "en",
+	"missverstehen": "en",
+	"misswirtschaften": "en",
+	"misten": "en",
+	"mitansehen": "en",
+	"mitarbeiten": "en",
+	"mitbekommen": "en",
+	"mitbenutzen": "en",
+	"mitbeschliessen": "en",
+	"mitbeschuldigen": "en",
+	"mitbestimmen": "en",
+	"mitbetreiben": "en",
+	"mitbewerben": "en",
+	"mitbringen": "en",
+	"mitdenken": "en",
+	"miteinbeziehen": "en",
+	"mitempfinden": "en",
+	"mitentscheiden": "en",
+	"miterleben": "en",
+	"mitessen": "en",
+	"mitfahren": "en",
+	"mitfilmen": "en",
+	"mitfinanzieren": "en",
+	"mitfliegen": "en",
+	"mitfreuen": "en",
+	"mitfühlen": "en",
+	"mitgeben": "en",
+	"mitgehen": "en",
+	"mitgestalten": "en",
+	"mithalten": "en",
+	"mithelfen": "en",
+	"mithören": "en",
+	"mitinitiieren": "en",
+	"mitkriegen": "en",
+	"mitkämpfen": "en",
+	"mitlassen": "en",
+	"mitlaufen": "en",
+	"mitleiden": "en",
+	"mitlernen": "en",
+	"mitliefern": "ern",
+	"mitmachen": "en",
+	"mitmischen": "en",
+	"mitnehmen": "en",
+	"mitprägen": "en",
+	"mitrechnen": "en",
+	"mitreden": "en",
+	"mitreisen": "en",
+	"mitreissen": "en",
+	"mitsaufen": "en",
+	"mitschleifen": "en",
+	"mitschleppen": "en",
+	"mitschneiden": "en",
+	"mitschreiben": "en",
+	"mitschwingen": "en",
+	"mitsingen": "en",
+	"mitspielen": "en",
+	"mitsprechen": "en",
+	"mitstreiten": "en",
+	"mitsurfen": "en",
+	"mittanzen": "en",
+	"mitteilen": "en",
+	"mitteln": "eln",
+	"mittragen": "en",
+	"mittrinken": "en",
+	"mitverdienen": "en",
+	"mitverschulden": "en",
+	"mitverursachen": "en",
+	"mitverändern": "ern",
+	"mitwirken": "en",
+	"mitwählen": "en",
+	"mitziehen": "en",
+	"mitzählen": "en",
+	"mixen": "en",
+	"mobilisieren": "en",
+	"modellieren": "en",
+	"modeln": "eln",
+	"moderieren": "en",
+	"modernisieren": "en",
+	"modifizieren": "en",
+	"modulieren": "en",
+	"mogeln": "eln",
+	"mokieren": "en",
+	"monieren": "en",
+	"monopolisieren": "en",
+	"montieren": "en",
+	"monumentalisieren": "en",
+	"mopsen": "en",
+	"moralisieren": "en",
+	"morden": "en",
+	"morsen": "en",
+	"mortifizieren": "en",
+	"mosern": "ern",
+	"motivieren": "en",
+	"motorisieren": "en",
+	"motten": "en",
+	"motzen": "en",
+	"mucken": "en",
+	"mucksen": "en",
+	"muffeln": "eln",
+	"muffen": "en",
+	"mumifizieren": "en",
+	"munden": "en",
+	"munkeln": "eln",
+	"muntern": "ern",
+	"murksen": "en",
+	"murmeln": "eln",
+	"murren": "en",
+	"musizieren": "en",
+	"mustern": "ern",
+	"muten": "en",
+	"mutieren": "en",
+	"mutmassen": "en",
+	"mystifizieren": "en",
+	"mähdreschen": "en",
+	"mähen": "en",
+	"mäkeln": "eln",
+	"mässigen": "en",
+	"mästen": "en",
+	"möbeln": "eln",
+	"möblieren": "en",
+	"möchten": "en",
+	"mögen": "en",
+	"mörteln": "eln",
+	"mühen": "en",
+	"mümmeln": "eln",
+	"münden": "en",
+	"münzen": "en",
+	"müssen": "en",
+	"nabeln": "eln",
+	"nachahmen": "en",
+	"nacharbeiten": "en",
+	"nachbauen": "en",
+	"nachberechnen": "en",
+	"nachbereiten": "en",
+	"nachbestellen": "en",
+	"nachbeten": "en",
+	"nachbilden": "en",
+	"nachbleiben": "en",
+	"nachbleichen": "en",
+	"nachblicken": "en",
+	"nachbluten": "en",
+	"nachbohren": "en",
+	"nachbringen": "en",
+	"nachdatieren": "en",
+	"nachdenken": "en",
+	"nachdichten": "en",
+	"nachdringen": "en",
+	"nachdrucken": "en",
+	"nachdrängen": "en",
+	"nachdunkeln": "eln",
+	"nacheifern": "ern",
+	"nacheilen": "en",
+	"nachempfinden": "en",
+	"nacherzählen": "en",
+	"nachessen": "en",
+	"nachfahren": "en",
+	"nachfassen": "en",
+	"nachfeiern": "ern",
+	"nachfilmen": "en",
+	"nachfolgen": "en",
+	"nachfordern": "ern",
+	"nachforschen": "en",
+	"nachfragen": "en",
+	"nachfühlen": "en",
+	"nachfüllen": "en",
+	"nachgeben": "en",
+	"nachgehen": "en",
+	"nachgeraten": "en",
+	"nachgiessen": "en",
+	"nachgrübeln": "eln",
+	"nachgären": "en",
+	"nachhaken": "en",
+	"nachhallen": "en",
+	"nachhelfen": "en",
+	"nachhinken": "en",
+	"nachholen": "en",
+	"nachhängen": "en",
+	"nachimpfen": "en",
+	"nachjagen": "en",
+	"nachkarten": "en",
+	"nachkaufen": "en",
+	"nachklingen": "en",
+	"nachkommen": "en",
+	"nachkontrollieren": "en",
+	"nachladen": "en",
+	"nachlassen": "en",
+	"nachlaufen": "en",
+	"nachleben": "en",
+	"nachlegen": "en",
+	"nachlernen": "en",
+	"nachlesen": "en",
+	"nachliefern": "ern",
+	"nachlösen": "en",
+	"nachmachen": "en",
+	"nachmalen": "en",
+	"nachmelden": "en",
+	"nachmessen": "en",
+	"nachnehmen": "en",
+	"nachpfeifen": "en",
+	"nachprüfen": "en",
+	"nachpumpen": "en",
+	"nachrechnen": "en",
+	"nachreden": "en",
+	"nachreichen": "en",
+	"nachreifen": "en",
+	"nachreisen": "en",
+	"nachrennen": "en",
+	"nachrufen": "en",
+	"nachrühmen": "en",
+	"nachrüsten": "en",
+	"nachsagen": "en",
+	"nachschaffen": "en",
+	"nachschauen": "en",
+	"nachschenken": "en",
+	"nachschlagen": "en",
+	"nachschleichen": "en",
+	"nachschleppen": "en",
+	"nachschmeissen": "en",
+	"nachschneiden": "en",
+	"nachschreiben": "en",
+	"nachschwimmen": "en",
+	"nachschütten": "en",
+	"nachsehen": "en",
+	"nachsenden": "en",
+	"nachsetzen": "en",
+	"nachsingen": "en",
+	"nachsinnen": "en",
+	"nachsitzen": "en",
+	"nachspielen": "en",
+	"nachsprechen": "en",
+	"nachspringen": "en",
+	"nachspülen": "en",
+	"nachspüren": "en",
+	"nachstehen": "en",
+	"nachsteigen": "en",
+	"nachstellen": "en",
+	"nachstimmen": "en",
+	"nachstreben": "en",
+	"nachstreichen": "en",
+	"nachstürzen": "en",
+	"nachsuchen": "en",
+	"nachtanken": "en",
+	"nachtragen": "en",
+	"nachtransportieren": "en",
+	"nachtrauern": "ern",
+	"nachtreten": "en",
+	"nachtönen": "en",
+	"nachversichern": "ern",
+	"nachvertonen": "en",
+	"nachvollziehen": "en",
+	"nachwachsen": "en",
+	"nachweinen": "en",
+	"nachweisen": "en",
+	"nachwerfen": "en",
+	"nachwiegen": "en",
+	"nachwinken": "en",
+	"nachwirken": "en",
+	"nachwählen": "en",
+	"nachzahlen": "en",
+	"nachzeichnen": "en",
+	"nachziehen": "en",
+	"nachzählen": "en",
+	"nachäffen": "en",
+	"nageln": "eln",
+	"nagen": "en",
+	"nahebringen": "en",
+	"nahegehen": "en",
+	"nahekommen": "en",
+	"nahelegen": "en",
+	"naheliegen": "en",
+	"nahen": "en",
+	"nahestehen": "en",
+	"nahetreten": "en",
+	"narkotisieren": "en",
+	"narren": "en",
+	"nasalieren": "en",
+	"naschen": "en",
+	"nationalisieren": "en",
+	"naturalisieren": "en",
+	"nebeln": "eln",
+	"nebenordnen": "en",
+	"negieren": "en",
+	"nehmen": "en",
+	"neiden": "en",
+	"neigen": "en",
+	"nennen": "en",
+	"neppen": "en",
+	"nerven": "en",
+	"nesteln": "eln",
+	"neuedieren": "en",
+	"neumachen": "en",
+	"neurotisieren": "en",
+	"neutralisieren": "en",
+	"nicken": "en",
+	"niederbeugen": "en",
+	"niederbiegen": "en",
+	"niederblasen": "en",
+	"niederbrechen": "en",
+	"niederbrennen": "en",
+	"niederbringen": "en",
+	"niederbügeln": "eln",
+	"niederfallen": "en",
+	"niedergehen": "en",
+	"niedergleiten": "en",
+	"niederhalten": "en",
+	"niederhauen": "en",
+	"niederholen": "en",
+	"niederknien": "en",
+	"niederknüppeln": "eln",
+	"niederkämpfen": "en",
+	"niederlassen": "en",
+	"niederlegen": "en",
+	"niedermachen": "en",
+	"niedermetzeln": "eln",
+	"niedermähen": "en",
+	"niederprasseln": "eln",
+	"niederreissen": "en",
+	"niederringen": "en",
+	"niedersausen": "en",
+	"niederschiessen": "en",
+	"niederschlagen": "en",
+	"niederschmettern": "ern",
+	"niederschreiben": "en",
+	"niederschreien": "en",
+	"niedersetzen": "en",
+	"niedersinken": "en",
+	"niederstampfen": "en",
+	"niederstechen": "en",
+	"niedersteigen": "en",
+	"niederstossen": "en",
+	"niederstrecken": "en",
+	"niederstürzen": "en",
+	"niedertrampeln": "eln",
+	"niedertreten": "en",
+	"niederwerfen": "en",
+	"niederzwingen": "en",
+	"nieseln": "eln",
+	"niesen": "en",
+	"nieten": "en",
+	"nippen": "en",
+	"nisten": "en",
+	"nivellieren": "en",
+	"nomadisieren": "en",
+	"nominalisieren": "en",
+	"nominieren": "en",
+	"norden": "en",
+	"normalisieren": "en",
+	"normen": "en",
+	"normieren": "en",
+	"notieren": "en",
+	"notlanden": "en",
+	"novellieren": "en",
+	"nuancieren": "en",
+	"nudeln": "eln",
+	"nullen": "en",
+	"numerieren": "en",
+	"nummerieren": "en",
+	"nuscheln": "eln",
+	"nutzen": "en",
+	"nächtigen": "en",
+	"nähen": "en",
+	"näherbringen": "en",
+	"näherkommen": "en",
+	"nähern": "ern",
+	"näherrücken": "en",
+	"näherstehen": "en",
+	"nähertreten": "en",
+	"nähren": "en",
+	"näseln": "eln",
+	"nässeln": "eln",
+	"nässen": "en",
+	"nölen": "en",
+	"nörgeln": "eln",
+	"nötigen": "en",
+	"nützen": "en",
+	"obduzieren": "en",
+	"objektivieren": "en",
+	"obliegen": "en",
+	"observieren": "en",
+	"obsessionieren": "en",
+	"obsiegen": "en",
+	"offenbaren": "en",
+	"offenbleiben": "en",
+	"offenhalten": "en",
+	"offenlassen": "en",
+	"offenlegen": "en",
+	"offenstehen": "en",
+	"offerieren": "en",
+	"ohrfeigen": "en",
+	"okkludieren": "en",
+	"okkupieren": "en",
+	"omittieren": "en",
+	"onanieren": "en",
+	"operieren": "en",
+	"opfern": "ern",
+	"opponieren": "en",
+	"optimieren": "en",
+	"orakeln": "eln",
+	"ordern": "ern",
+	"ordinieren": "en",
+	"ordnen": "en",
+	"organisieren": "en",
+	"orgeln": "eln",
+	"orientieren": "en",
+	"orten": "en",
+	"oszillieren": "en",
+	"outsourcen": "en",
+	"oxidieren": "en",
+	"ozonisieren": "en",
+	"paaren": "en",
+	"pachten": "en",
+	"packen": "en",
+	"paddeln": "eln",
+	"paffen": "en",
+	"paktieren": "en",
+	"palavern": "ern",
+	"panieren": "en",
+	"panschen": "en",
+	"pantschen": "en",
+	"panzern": "ern",
+	"pappen": "en",
+	"parabolisieren": "en",
+	"paragraphieren": "en",
+	"parallelschalten": "en",
+	"paralysieren": "en",
+	"paraphieren": "en",
+	"paraphrasieren": "en",
+	"parfümieren": "en",
+	"parieren": "en",
+	"parken": "en",
+	"parkettieren": "en",
+	"parlieren": "en",
+	"parodieren": "en",
+	"parsen": "en",
+	"partizipieren": "en",
+	"parzellieren": "en",
+	"passen": "en",
+	"passieren": "en",
+	"patentieren": "en",
+	"patschen": "en",
+	"patzen": "en",
+	"pauken": "en",
+	"pauschalieren": "en",
+	"pauschalisieren": "en",
+	"pausen": "en",
+	"pausieren": "en",
+	"pediküren": "en",
+	"peilen": "en",
+	"peinigen": "en",
+	"peitschen": "en",
+	"pellen": "en",
+	"pendeln": "eln",
+	"pennen": "en",
+	"pensionieren": "en",
+	"perfektionieren": "en",
+	"perforieren": "en",
+	"periodisieren": "en",
+	"perlen": "en",
+	"permutieren": "en",
+	"personalisieren": "en",
+	"personifizieren": "en",
+	"pervertieren": "en",
+	"pesen": "en",
+	"petitionieren": "en",
+	"petzen": "en",
+	"pfeffern": "ern",
+	"pfeifen": "en",
+	"pferchen": "en",
+	"pflanzen": "en",
+	"pflastern": "ern",
+	"pflaumen": "en",
+	"pflegen": "en",
+	"pflichten": "en",
+	"pflücken": "en",
+	"pflügen": "en",
+	"pfropfen": "en",
+	"pfuschen": "en",
+	"pfänden": "en",
+	"phantasieren": "en",
+	"philosophieren": "en",
+	"photographieren": "en",
+	"picheln": "eln",
+	"picken": "en",
+	"piepen": "en",
+	"piepsen": "en",
+	"pieseln": "eln",
+	"pigmentieren": "en",
+	"pilgern": "ern",
+	"pinkeln": "eln",
+	"pinnen": "en",
+	"pinseln": "eln",
+	"pirschen": "en",
+	"pissen": "en",
+	"plagen": "en",
+	"plakatieren": "en",
+	"planen": "en",
+	"planieren": "en",
+	"planschen": "en",
+	"plappern": "ern",
+	"platschen": "en",
+	"plattmachen": "en",
+	"platzen": "en",
+	"platzieren": "en",
+	"plaudern": "ern",
+	"plauschen": "en",
+	"plazieren": "en",
+	"plempern": "ern",
+	"plombieren": "en",
+	"plotten": "en",
+	"plumpsen": "en",
+	"plustern": "ern",
+	"plädieren": "en",
+	"plätschern": "ern",
+	"plätten": "en",
+	"plündern": "ern",
+	"pochen": "en",
+	"poetisieren": "en",
+	"pokern": "ern",
+	"polarisieren": "en",
+	"polemisieren": "en",
+	"polen": "en",
+	"polieren": "en",
+	"politisieren": "en",
+	"polstern": "ern",
+	"poltern": "ern",
+	"poolen": "en",
+	"popeln": "eln",
+	"poppen": "en",
+	"popularisieren": "en",
+	"portieren": "en",
+	"portionieren": "en",
+	"porträtieren": "en",
+	"posaunen": "en",
+	"posieren": "en",
+	"positionieren": "en",
+	"postieren": "en",
+	"postulieren": "en",
+	"potenzieren": "en",
+	"powern": "ern",
+	"prahlen": "en",
+	"praktizieren": "en",
+	"prallen": "en",
+	"prangen": "en",
+	"prangern": "ern",
+	"prasseln": "eln",
+	"predigen": "en",
+	"preisen": "en",
+	"preisgeben": "en",
+	"prellen": "en",
+	"preschen": "en",
+	"pressen": "en",
+	"pressieren": "en",
+	"pritschen": "en",
+	"privatisieren": "en",
+	"privilegieren": "en",
+	"proben": "en",
+	"probieren": "en",
+	"problematisieren": "en",
+	"produzieren": "en",
+	"professionalisieren": "en",
+	"profilieren": "en",
+	"profitieren": "en",
+	"prognostizieren": "en",
+	"programmieren": "en",
+	"prohibieren": "en",
+	"projektieren": "en",
+	"projizieren": "en",
+	"proklamieren": "en",
+	"proletarisieren": "en",
+	"promoten": "en",
+	"promovieren": "en",
+	"propagandieren": "en",
+	"propagieren": "en",
+	"prophezeien": "en",
+	"prosperieren": "en",
+	"prosten": "en",
+	"protegieren": "en",
+	"protestieren": "en",
+	"protokollieren": "en",
+	"protzen": "en",
+	"provozieren": "en",
+	"prozessieren": "en",
+	"prusten": "en",
+	"prädestinieren": "en",
+	"präferieren": "en",
+	"präfigieren": "en",
+	"prägen": "en",
+	"prämieren": "en",
+	"präparieren": "en",
+	"präsentieren": "en",
+	"präsidieren": "en",
+	"präzisieren": "en",
+	"prüfen": "en",
+	"prügeln": "eln",
+	"pubertieren": "en",
+	"publizieren": "en",
+	"pudern": "ern",
+	"puffen": "en",
+	"pulen": "en",
+	"pulsieren": "en",
+	"pulvern": "ern",
+	"pumpen": "en",
+	"punkten": "en",
+	"punktieren": "en",
+	"pupen": "en",
+	"purzeln": "eln",
+	"puschen": "en",
+	"pusten": "en",
+	"putschen": "en",
+	"putten": "en",
+	"putzen": "en",
+	"päppeln": "eln",
+	"pöbeln": "eln",
+	"pökeln": "eln",
+	"quadrieren": "en",
+	"quaken": "en",
+	"qualifizieren": "en",
+	"qualmen": "en",
+	"quantifizieren": "en",
+	"quartieren": "en",
+	"quarzen": "en",
+	"quasseln": "eln",
+	"quatschen": "en",
+	"quellen": "en",
+	"quengeln": "eln",
+	"querdenken": "en",
+	"queren": "en",
+	"querlegen": "en",
+	"querschiessen": "en",
+	"quetschen": "en",
+	"quiecken": "en",
+	"quietschen": "en",
+	"quirlen": "en",
+	"quittieren": "en",
+	"quotieren": "en",
+	"quälen": "en",
+	"rabattieren": "en",
+	"rackern": "ern",
+	"radeln": "eln",
+	"radfahren": "en",
+	"radieren": "en",
+	"radikalisieren": "en",
+	"raffen": "en",
+	"raffinieren": "en",
+	"ragen": "en",
+	"rahmen": "en",
+	"rammeln": "eln",
+	"rammen": "en",
+	"ramponieren": "en",
+	"ramschen": "en",
+	"randalieren": "en",
+	"rangieren": "en",
+	"ranken": "en",
+	"ranklotzen": "en",
+	"rankriegen": "en",
+	"ranschmeissen": "en",
+	"ranschweissen": "en",
+	"ranzen": "en",
+	"rappeln": "eln",
+	"rascheln": "eln",
+	"rasen": "en",
+	"rasieren": "en",
+	"raspeln": "eln",
+	"rasseln": "eln",
+	"rasten": "en",
+	"rastern": "ern",
+	"raten": "en",
+	"ratifizieren": "en",
+	"rationalisieren": "en",
+	"rationieren": "en",
+	"rattern": "ern",
+	"ratzen": "en",
+	"rauben": "en",
+	"rauchen": "en",
+	"rauen": "en",
+	"raufen": "en",
+	"rauhen": "en",
+	"raunen": "en",
+	"raunzen": "en",
+	"rauschen": "en",
+	"rausfinden": "en",
+	"rausfliegen": "en",
+	"raushängen": "en",
+	"rauskommen": "en",
+	"rauslaufen": "en",
+	"rausrücken": "en",
+	"reagieren": "en",
+	"reaktivieren": "en",
+	"realisieren": "en",
+	"reanimieren": "en",
+	"rebellieren": "en",
+	"rechnen": "en",
+	"rechtfertigen": "en",
+	"recken": "en",
+	"recyceln": "eln",
+	"reden": "en",
+	"redigieren": "en",
+	"reduzieren": "en",
+	"reetablieren": "en",
+	"referieren": "en",
+	"refinanzieren": "en",
+	"reflektieren": "en",
+	"reformieren": "en",
+	"refundieren": "en",
+	"regeln": "eln",
+	"regen": "en",
+	"regenerieren": "en",
+	"regieren": "en",
+	"registrieren": "en",
+	"regnen": "en",
+	"regulieren": "en",
+	"rehabilitieren": "en",
+	"reiben": "en",
+	"reichen": "en",
+	"reichern": "ern",
+	"reifen": "en",
+	"reihen": "en",
+	"reihern": "ern",
+	"reimen": "en",
+	"reinfallen": "en",
+	"reingehen": "en",
+	"reinigen": "en",
+	"reinkarnieren": "en",
+	"reinreden": "en",
+	"reinreissen": "en",
+	"reinreiten": "en",
+	"reinriechen": "en",
+	"reinwaschen": "en",
+	"reinwürgen": "en",
+	"reisen": "en",
+	"reissen": "en",
+	"reiten": "en",
+	"reizen": "en",
+	"rekapitulieren": "en",
+	"reklamieren": "en",
+	"rekonstruieren": "en",
+	"rekrutieren": "en",
+	"rektifizieren": "en",
+	"rekurrieren": "en",
+	"relativieren": "en",
+	"remilitarisieren": "en",
+	"remontieren": "en",
+	"rempeln": "eln",
+	"renaturieren": "en",
+	"renken": "en",
+	"rennen": "en",
+	"renovieren": "en",
+	"rentieren": "en",
+	"renumerieren": "en",
+	"reorganisieren": "en",
+	"reparieren": "en",
+	"reprivatisieren": "en",
+	"reproduzieren": "en",
+	"repräsentieren": "en",
+	"requirieren": "en",
+	"reservieren": "en",
+	"residieren": "en",
+	"resignieren": "en",
+	"resistieren": "en",
+	"resorbieren": "en",
+	"resozialisieren": "en",
+	"respektieren": "en",
+	"restaurieren": "en",
+	"restrukturieren": "en",
+	"resultieren": "en",
+	"resumieren": "en",
+	"resümieren": "en",
+	"retardieren": "en",
+	"retten": "en",
+	"retuschieren": "en",
+	"revanchieren": "en",
+	"revidieren": "en",
+	"revolutionieren": "en",
+	"rezensieren": "en",
+	"rezipieren": "en",
+	"rezitieren": "en",
+	"ribbeln": "eln",
+	"richten": "en",
+	"richtigliegen": "en",
+	"richtigstellen": "en",
+	"riechen": "en",
+	"riegeln": "eln",
+	"rieseln": "eln",
+	"riffeln": "eln",
+	"rillen": "en",
+	"rinden": "en",
+	"ringeln": "eln",
+	"ringen": "en",
+	"rinnen": "en",
+	"rippen": "en",
+	"riskieren": "en",
+	"ritualisieren": "en",
+	"ritzen": "en",
+	"robben": "en",
+	"rodeln": "eln",
+	"roden": "en",
+	"rollen": "en",
+	"rosten": "en",
+	"rotieren": "en",
+	"rotten": "en",
+	"rotzen": "en",
+	"rubbeln": "eln",
+	"ruckeln": "eln",
+	"rudern": "ern",
+	"rufen": "en",
+	"ruhen": "en",
+	"ruhigstellen": "en",
+	"ruinieren": "en",
+	"rumhantieren": "en",
+	"rumhängen": "en",
+	"rumlaufen": "en",
+	"rummalen": "en",
+	"rumnölen": "en",
+	"rumoren": "en",
+	"rumpeln": "eln",
+	"runden": "en",
+	"rundgehen": "en",
+	"runterdosieren": "en",
+	"runterkochen": "en",
+	"runterkommen": "en",
+	"runtersetzen": "en",
+	"runterstarten": "en",
+	"runzeln": "eln",
+	"rupfen": "en",
+	"rutschen": "en",
+	"rächen": "en",
+	"rädern": "ern",
+	"räkeln": "eln",
+	"räsonieren": "en",
+	"rätseln": "eln",
+	"räuchern": "ern",
+	"räumen": "en",
+	"räuspern": "ern",
+	"röcheln": "eln",
+	"röhren": "en",
+	"röntgen": "en",
+	"rösten": "en",
+	"röten": "en",
+	"rüberbringen": "en",
+	"rüberfliegen": "en",
+	"rückblicken": "en",
+	"rückdatieren": "en",
+	"rücken": "en",
+	"rückerstatten": "en",
+	"rückversichern": "ern",
+	"rückwärtsgehen": "en",
+	"rüffeln": "eln",
+	"rügen": "en",
+	"rühmen": "en",
+	"rühren": "en",
+	"rülpsen": "en",
+	"rümpfen": "en",
+	"rüsseln": "eln",
+	"rüsten": "en",
+	"rütteln": "eln",
+	"sabbeln": "eln",
+	"sabbern": "ern",
+	"sabotieren": "en",
+	"sacken": "en",
+	"saften": "en",
+	"sagen": "en",
+	"sahnen": "en",
+	"salben": "en",
+	"salutieren": "en",
+	"salzen": "en",
+	"samen": "en",
+	"sammeln": "eln",
+	"sanieren": "en",
+	"sanktionieren": "en",
+	"sargen": "en",
+	"satteln": "eln",
+	"saturieren": "en",
+	"sauberhalten": "en",
+	"sauen": "en",
+	"sauern": "ern",
+	"saufen": "en",
+	"saugbohnern": "ern",
+	"saugen": "en",
+	"saunen": "en",
+	"sausen": "en",
+	"schaben": "en",
+	"schachern": "ern",
+	"schachteln": "eln",
+	"schachten": "en",
+	"schaden": "en",
+	"schaffen": "en",
+	"schalen": "en",
+	"schallen": "en",
+	"schalten": "en",
+	"schamponieren": "en",
+	"schanzen": "en",
+	"scharen": "en",
+	"scharfstellen": "en",
+	"scharmützeln": "eln",
+	"scharren": "en",
+	"schattieren": "en",
+	"schaudern": "ern",
+	"schauen": "en",
+	"schaufeln": "eln",
+	"schaukeln": "eln",
+	"schauspielern": "ern",
+	"scheffeln": "eln",
+	"scheiden": "en",
+	"scheinen": "en",
+	"scheissen": "en",
+	"scheitern": "ern",
+	"schellen": "en",
+	"schelten": "en",
+	"schematisieren": "en",
+	"schenken": "en",
+	"scheppern": "ern",
+	"scheren": "en",
+	"scherzen": "en",
+	"scheuchen": "en",
+	"scheuen": "en",
+	"scheuern": "ern",
+	"schichten": "en",
+	"schicken": "en",
+	"schieben": "en",
+	"schiefern": "ern",
+	"schiefgehen": "en",
+	"schieflachen": "en",
+	"schieflaufen": "en",
+	"schiefliegen": "en",
+	"schielen": "en",
+	"schiessen": "en",
+	"schiffen": "en",
+	"schikanieren": "en",
+	"schildern": "ern",
+	"schillern": "ern",
+	"schimmeln": "eln",
+	"schimmern": "ern",
+	"schimpfen": "en",
+	"schinden": "en",
+	"schirmen": "en",
+	"schirren": "en",
+	"schlabbern": "ern",
+	"schlachten": "en",
+	"schlafen": "en",
+	"schlaffen": "en",
+	"schlafwandeln": "eln",
+	"schlagen": "en",
+	"schlampen": "en",
+	"schlappen": "en",
+	"schlappmachen": "en",
+	"schlauchen": "en",
+	"schlechtgehen": "en",
+	"schleckern": "ern",
+	"schleichen": "en",
+	"schleifen": "en",
+	"schleimen": "en",
+	"schleissen": "en",
+	"schlemmen": "en",
+	"schlendern": "ern",
+	"schlenkern": "ern",
+	"schlenzen": "en",
+	"schleppen": "en",
+	"schleudern": "ern",
+	"schleusen": "en",
+	"schlichten": "en",
+	"schliessen": "en",
+	"schlingen": "en",
+	"schlingern": "ern",
+	"schlittern": "ern",
+	"schlitzen": "en",
+	"schlossern": "ern",
+	"schlottern": "ern",
+	"schluchzen": "en",
+	"schlucken": "en",
+	"schludern": "ern",
+	"schlummern": "ern",
+	"schlussfolgern": "ern",
+	"schläfern": "ern",
+	"schlämmen": "en",
+	"schlängeln": "eln",
+	"schlüpfen": "en",
+	"schlürfen": "en",
+	"schlüsseln": "eln",
+	"schmachten": "en",
+	"schmarotzen": "en",
+	"schmatzen": "en",
+	"schmecken": "en",
+	"schmeicheln": "eln",
+	"schmeissen": "en",
+	"schmelzen": "en",
+	"schmerzen": "en",
+	"schmettern": "ern",
+	"schmieden": "en",
+	"schmiegen": "en",
+	"schmieren": "en",
+	"schminken": "en",
+	"schmirgeln": "eln",
+	"schmollen": "en",
+	"schmoren": "en",
+	"schmuddeln": "eln",
+	"schmuggeln": "eln",
+	"schmunzeln": "eln",
+	"schmusen": "en",
+	"schmähen": "en",
+	"schmälern": "ern",
+	"schmökern": "ern",
+	"schmücken": "en",
+	"schnallen": "en",
+	"schnalzen": "en",
+	"schnappen": "en",
+	"schnarchen": "en",
+	"schnarren": "en",
+	"schnattern": "ern",
+	"schnauben": "en",
+	"schnaufen": "en",
+	"schnauzen": "en",
+	"schneiden": "en",
+	"schneidern": "ern",
+	"schneien": "en",
+	"schnellen": "en",
+	"schneuzen": "en",
+	"schniefen": "en",
+	"schnippen": "en",
+	"schnipseln": "eln",
+	"schnipsen": "en",
+	"schnitzen": "en",
+	"schnorcheln": "eln",
+	"schnorren": "en",
+	"schnuppern": "ern",
+	"schnurren": "en",
+	"schnäuzen": "en",
+	"schnüffeln": "eln",
+	"schnüren": "en",
+	"schocken": "en",
+	"schockfrosten": "en",
+	"schockieren": "en",
+	"schonen": "en",
+	"schoppen": "en",
+	"schottern": "ern",
+	"schraffieren": "en",
+	"schrammen": "en",
+	"schrauben": "en",
+	"schrecken": "en",
+	"schreiben": "en",
+	"schreien": "en",
+	"schreinern": "ern",
+	"schreiten": "en",
+	"schrubben": "en",
+	"schrumpeln": "eln",
+	"schrumpfen": "en",
+	"schrägen": "en",
+	"schränken": "en",
+	"schröpfen": "en",
+	"schubsen": "en",
+	"schuckeln": "eln",
+	"schuften": "en",
+	"schulden": "en",
+	"schulen": "en",
+	"schultern": "ern",
+	"schummeln": "eln",
+	"schunkeln": "eln",
+	"schustern": "ern",
+	"schwabbeln": "eln",
+	"schwafeln": "eln",
+	"schwanen": "en",
+	"schwanken": "en",
+	"schwappen": "en",
+	"schwarzarbeiten": "en",
+	"schwarzhören": "en",
+	"schwarzmalen": "en",
+	"schwarzschlachten": "en",
+	"schwarzsehen": "en",
+	"schwatzen": "en",
+	"schweben": "en",
+	"schwefeln": "eln",
+	"schweifen": "en",
+	"schweigen": "en",
+	"schweissen": "en",
+	"schwelen": "en",
+	"schwelgen": "en",
+	"schwellen": "en",
+	"schwemmen": "en",
+	"schwenken": "en",
+	"schwerfallen": "en",
+	"schwermachen": "en",
+	"schwimmen": "en",
+	"schwindeln": "eln",
+	"schwinden": "en",
+	"schwingen": "en",
+	"schwirbeln": "eln",
+	"schwirren": "en",
+	"schwitzen": "en",
+	"schwäbeln": "eln",
+	"schwächen": "en",
+	"schwängern": "ern",
+	"schwänzen": "en",
+	"schwären": "en",
+	"schwärmen": "en",
+	"schwärzen": "en",
+	"schwören": "en",
+	"schädigen": "en",
+	"schälen": "en",
+	"schämen": "en",
+	"schänden": "en",
+	"schärfen": "en",
+	"schätzen": "en",
+	"schäumen": "en",
+	"schönen": "en",
+	"schönfärben": "en",
+	"schönreden": "en",
+	"schöpfen": "en",
+	"schüren": "en",
+	"schürfen": "en",
+	"schütteln": "eln",
+	"schütten": "en",
+	"schüttern": "ern",
+	"schützen": "en",
+	"segeln": "eln",
+	"segmentieren": "en",
+	"segnen": "en",
+	"sehen": "en",
+	"sehnen": "en",
+	"seifen": "en",
+	"seilen": "en",
+	"sein": "n",
+	"sekretieren": "en",
+	"sekundieren": "en",
+	"selektieren": "en",
+	"seligpreisen": "en",
+	"seligsprechen": "en",
+	"senden": "en",
+	"sengen": "en",
+	"senken": "en",
+	"sensibilisieren": "en",
+	"separieren": "en",
+	"servieren": "en",
+	"setzen": "en",
+	"seufzen": "en",
+	"sezieren": "en",
+	"sichern": "ern",
+	"sicherstellen": "en",
+	"sichten": "en",
+	"sickern": "ern",
+	"sieben": "en",
+	"siechen": "en",
+	"siedeln": "eln",
+	"sieden": "en",
+	"siegeln": "eln",
+	"siegen": "en",
+	"siezen": "en",
+	"signalisieren": "en",
+	"simulieren": "en",
+	"singen": "en",
+	"singularisieren": "en",
+	"sinken": "en",
+	"sinnen": "en",
+	"sinnieren": "en",
+	"sitzen": "en",
+	"sitzenbleiben": "en",
+	"skalpieren": "en",
+	"skandieren": "en",
+	"skelettieren": "en",
+	"skizzieren": "en",
+	"sohlen": "en",
+	"solidarisieren": "en",
+	"sollen": "en",
+	"sondern": "ern",
+	"sondieren": "en",
+	"sonnen": "en",
+	"sorgen": "en",
+	"sortieren": "en",
+	"sozialisieren": "en",
+	"spachteln": "eln",
+	"spalten": "en",
+	"spannen": "en",
+	"sparen": "en",
+	"spassen": "en",
+	"spazieren": "en",
+	"spazierenführen": "en",
+	"spazierengehen": "en",
+	"spedieren": "en",
+	"speichern": "ern",
+	"speien": "en",
+	"speisen": "en",
+	"spekulieren": "en",
+	"spenden": "en",
+	"spendieren": "en",
+	"sperren": "en",
+	"spezialisieren": "en",
+	"spezifizieren": "en",
+	"spicken": "en",
+	"spiegeln": "eln",
+	"spielen": "en",
+	"spiessen": "en",
+	"spinnen": "en",
+	"spionieren": "en",
+	"spitzbekommen": "en",
+	"spitzeln": "eln",
+	"spitzen": "en",
+	"spitzkriegen": "en",
+	"spleissen": "en",
+	"splittern": "ern",
+	"sponsern": "ern",
+	"spornen": "en",
+	"spotten": "en",
+	"sprechen": "en",
+	"spreizen": "en",
+	"sprengen": "en",
+	"sprenkeln": "eln",
+	"spriessen": "en",
+	"springen": "en",
+	"sprinten": "en",
+	"spritzen": "en",
+	"sprudeln": "eln",
+	"sprühen": "en",
+	"spucken": "en",
+	"spuken": "en",
+	"spulen": "en",
+	"spuren": "en",
+	"spurten": "en",
+	"sputen": "en",
+	"spähen": "en",
+	"spülen": "en",
+	"spüren": "en",
+	"stabilisieren": "en",
+	"stacheln": "eln",
+	"staffeln": "eln",
+	"staffieren": "en",
+	"stagnieren": "en",
+	"stammeln": "eln",
+	"stammen": "en",
+	"stampfen": "en",
+	"standardisieren": "en",
+	"standhalten": "en",
+	"stanzen": "en",
+	"stapeln": "eln",
+	"stapfen": "en",
+	"starkmachen": "en",
+	"starren": "en",
+	"starten": "en",
+	"stationieren": "en",
+	"statten": "en",
+	"stattfinden": "en",
+	"stattgeben": "en",
+	"statuieren": "en",
+	"stauben": "en",
+	"staubsaugen": "en",
+	"stauchen": "en",
+	"stauen": "en",
+	"staunen": "en",
+	"stechen": "en",
+	"stecken": "en",
+	"steckenbleiben": "en",
+	"stehen": "en",
+	"stehenbleiben": "en",
+	"stehenlassen": "en",
+	"stehlen": "en",
+	"steifen": "en",
+	"steigen": "en",
+	"steigern": "ern",
+	"steinigen": "en",
+	"stellen": "en",
+	"stemmen": "en",
+	"stempeln": "eln",
+	"steppen": "en",
+	"sterben": "en",
+	"sterilisieren": "en",
+	"steuern": "ern",
+	"stibitzen": "en",
+	"sticheln": "eln",
+	"stieben": "en",
+	"stiefeln": "eln",
+	"stielen": "en",
+	"stieren": "en",
+	"stiften": "en",
+	"stigmatisieren": "en",
+	"stilisieren": "en",
+	"stillegen": "en",
+	"stillen": "en",
+	"stillhalten": "en",
+	"stilllegen": "en",
+	"stillschweigen": "en",
+	"stillsitzen": "en",
+	"stimmen": "en",
+	"stimulieren": "en",
+	"stinken": "en",
+	"stippen": "en",
+	"stochern": "ern",
+	"stocken": "en",
+	"stolpern": "ern",
+	"stolzieren": "en",
+	"stopfen": "en",
+	"stoppeln": "eln",
+	"stoppen": "en",
+	"stornieren": "en",
+	"stossen": "en",
+	"stottern": "ern",
+	"strafen": "en",
+	"straffen": "en",
+	"strahlen": "en",
+	"strammstehen": "en",
+	"strampeln": "eln",
+	"stranden": "en",
+	"strangulieren": "en",
+	"strapazieren": "en",
+	"straucheln": "eln",
+	"streben": "en",
+	"strecken": "en",
+	"streicheln": "eln",
+	"streichen": "en",
+	"streifen": "en",
+	"streiken": "en",
+	"streiten": "en",
+	"strengen": "en",
+	"stressen": "en",
+	"streuen": "en",
+	"streunen": "en",
+	"streuseln": "eln",
+	"stricken": "en",
+	"striegeln": "eln",
+	"strippen": "en",
+	"strolchen": "en",
+	"stromern": "ern",
+	"strotzen": "en",
+	"strudeln": "eln",
+	"strukturieren": "en",
+	"strullen": "en",
+	"sträuben": "en",
+	"strömen": "en",
+	"studieren": "en",
+	"stufen": "en",
+	"stumpfen": "en",
+	"stunden": "en",
+	"stutzen": "en",
+	"stählen": "en",
+	"stänkern": "ern",
+	"stärken": "en",
+	"stöbern": "ern",
+	"stöhnen": "en",
+	"stöpseln": "eln",
+	"stören": "en",
+	"stückeln": "eln",
+	"stülpen": "en",
+	"stümmeln": "eln",
+	"stümpern": "ern",
+	"stürben": "en",
+	"stürmen": "en",
+	"stürzen": "en",
+	"stützen": "en",
+	"substituieren": "en",
+	"subsumieren": "en",
+	"subtrahieren": "en",
+	"subventionieren": "en",
+	"suchen": "en",
+	"sudeln": "eln",
+	"suffigieren": "en",
+	"suggerieren": "en",
+	"suhlen": "en",
+	"summen": "en",
+	"summieren": "en",
+	"sumpfen": "en",
+	"surren": "en",
+	"suspendieren": "en",
+	"swingen": "en",
+	"symbolisieren": "en",
+	"sympathisieren": "en",
+	"sympatisieren": "en",
+	"synthetisieren": "en",
+	"systematisieren": "en",
+	"säbeln": "eln",
+	"säen": "en",
+	"sägen": "en",
+	"sähen": "en",
+	"säkularisieren": "en",
+	"sänftigen": "en",
+	"sättigen": "en",
+	"säubern": "ern",
+	"säuern": "ern",
+	"säumen": "en",
+	"säuseln": "eln",
+	"söhnen": "en",
+	"süffeln": "eln",
+	"sühnen": "en",
+	"sülzen": "en",
+	"sündigen": "en",
+	"süssen": "en",
+	"tabellarisieren": "en",
+	"tabellieren": "en",
+	"tabuieren": "en",
+	"tadeln": "eln",
+	"tafeln": "eln",
+	"tagen": "en",
+	"takeln": "eln",
+	"taktieren": "en",
+	"tamponieren": "en",
+	"tangieren": "en",
+	"tanken": "en",
+	"tanzen": "en",
+	"tapern": "ern",
+	"tapezieren": "en",
+	"tappen": "en",
+	"tapsen": "en",
+	"tarieren": "en",
+	"tarnen": "en",
+	"tasten": "en",
+	"tatschen": "en",
+	"tauchen": "en",
+	"tauen": "en",
+	"taufen": "en",
+	"taugen": "en",
+	"taumeln": "eln",
+	"tauschen": "en",
+	"taxieren": "en",
+	"technisieren": "en",
+	"teeren": "en",
+	"teilen": "en",
+	"teilhaben": "en",
+	"teilnehmen": "en",
+	"telefonieren": "en",
+	"temperieren": "en",
+	"tendieren": "en",
+	"terminieren": "en",
+	"terrorisieren": "en",
+	"testen": "en",
+	"testieren": "en",
+	"texten": "en",
+	"thematisieren": "en",
+	"theoretisieren": "en",
+	"therapieren": "en",
+	"thesaurieren": "en",
+	"thronen": "en",
+	"ticken": "en",
+	"tigern": "ern",
+	"tilgen": "en",
+	"tingeln": "eln",
+	"tippeln": "eln",
+	"tippen": "en",
+	"tirilieren": "en",
+	"tischen": "en",
+	"tischlern": "ern",
+	"titelen": "en",
+	"titschen": "en",
+	"titulieren": "en",
+	"toasten": "en",
+	"toben": "en",
+	"tolerieren": "en",
+	"tollen": "en",
+	"topfen": "en",
+	"toppen": "en",
+	"torkeln": "eln",
+	"torpedieren": "en",
+	"tosen": "en",
+	"totalisieren": "en",
+	"totarbeiten": "en",
+	"totkriegen": "en",
+	"totlachen": "en",
+	"totsagen": "en",
+	"totschlagen": "en",
+	"totschweigen": "en",
+	"totsparen": "en",
+	"totstechen": "en",
+	"totstellen": "en",
+	"tottreten": "en",
+	"totärgern": "ern",
+	"touren": "en",
+	"traben": "en",
+	"trachten": "en",
+	"tradieren": "en",
+	"tragen": "en",
+	"trainieren": "en",
+	"traktieren": "en",
+	"trampeln": "eln",
+	"transchieren": "en",
+	"transferieren": "en",
+	"transformieren": "en",
+	"transplantieren": "en",
+	"transponieren": "en",
+	"transportieren": "en",
+	"transzendieren": "en",
+	"trappen": "en",
+	"trapsen": "en",
+	"trassieren": "en",
+	"trauen": "en",
+	"trauern": "ern",
+	"traumwandeln": "eln",
+	"treffen": "en",
+	"treiben": "en",
+	"trennen": "en",
+	"treten": "en",
+	"trichtern": "ern",
+	"tricksen": "en",
+	"triefen": "en",
+	"triezen": "en",
+	"trillern": "ern",
+	"trimmen": "en",
+	"trinken": "en",
+	"trippeln": "eln",
+	"triumphieren": "en",
+	"trocknen": "en",
+	"trommeln": "eln",
+	"trompeten": "en",
+	"tropfen": "en",
+	"trotten": "en",
+	"trotzen": "en",
+	"trudeln": "eln",
+	"trumpfen": "en",
+	"trällern": "ern",
+	"tränen": "en",
+	"tränken": "en",
+	"träufeln": "eln",
+	"träufen": "en",
+	"träumen": "en",
+	"trödeln": "eln",
+	"tröpfeln": "eln",
+	"trösten": "en",
+	"trüben": "en",
+	"trügen": "en",
+	"tuckern": "ern",
+	"tummeln": "eln",
+	"tun": "n",
+	"tunken": "en",
+	"tupfen": "en",
+	"turnen": "en",
+	"turteln": "eln",
+	"tuscheln": "eln",
+	"tuschieren": "en",
+	"tuten": "en",
+	"typisieren": "en",
+	"tyrannisieren": "en",
+	"täfeln": "eln",
+	"tändeln": "eln",
+	"tänzeln": "eln",
+	"tätigen": "en",
+	"tätowieren": "en",
+	"tätscheln": "eln",
+	"täuschen": "en",
+	"tönen": "en",
+	"töpfern": "ern",
+	"törnen": "en",
+	"töten": "en",
+	"tüfteln": "eln",
+	"tünchen": "en",
+	"türken": "en",
+	"türmen": "en",
+	"tüten": "en",
+	"ufern": "ern",
+	"ulken": "en",
+	"umarbeiten": "en",
+	"umarmen": "en",
+	"umbauen": "en",
+	"umbehalten": "en",
+	"umbenennen": "en",
+	"umbesetzen": "en",
+	"umbetten": "en",
+	"umbiegen": "en",
+	"umbilden": "en",
+	"umbinden": "en",
+	"umblasen": "en",
+	"umblättern": "ern",
+	"umbrechen": "en",
+	"umbringen": "en",
+	"umbuchen": "en",
+	"umdenken": "en",
+	"umdeuten": "en",
+	"umdichten": "en",
+	"umdisponieren": "en",
+	"umdrehen": "en",
+	"umerziehen": "en",
+	"umetikettieren": "en",
+	"umfahren": "en",
+	"umfallen": "en",
+	"umfassen": "en",
+	"umfliegen": "en",
+	"umfliessen": "en",
+	"umformen": "en",
+	"umformieren": "en",
+	"umformulieren": "en",
+	"umfragen": "en",
+	"umfunktionieren": "en",
+	"umfärben": "en",
+	"umfüllen": "en",
+	"umgarnen": "en",
+	"umgeben": "en",
+	"umgehen": "en",
+	"umgestalten": "en",
+	"umgiessen": "en",
+	"umgraben": "en",
+	"umgruppieren": "en",
+	"umgrätschen": "en",
+	"umhauen": "en",
+	"umherfahren": "en",
+	"umherflattern": "ern",
+	"umherfliegen": "en",
+	"umherirren": "en",
+	"umherjagen": "en",
+	"umherkriechen": "en",
+	"umherreisen": "en",
+	"umherreiten": "en",
+	"umherschauen": "en",
+	"umherschleichen": "en",
+	"umherschlendern": "ern",
+	"umherstreifen": "en",
+	"umhertappen": "en",
+	"umhertasten": "en",
+	"umhertreiben": "en",
+	"umherwandern": "ern",
+	"umherwimmeln": "eln",
+	"umherziehen": "en",
+	"umhängen": "en",
+	"umhören": "en",
+	"umhüllen": "en",
+	"uminterpretieren": "en",
+	"umkehren": "en",
+	"umkippen": "en",
+	"umklammern": "ern",
+	"umklappen": "en",
+	"umkleiden": "en",
+	"umknicken": "en",
+	"umkommen": "en",
+	"umkonstruieren": "en",
+	"umkreisen": "en",
+	"umkrempeln": "eln",
+	"umladen": "en",
+	"umlagern": "ern",
+	"umlaufen": "en",
+	"umlauten": "en",
+	"umlegen": "en",
+	"umleiten": "en",
+	"umlenken": "en",
+	"umlernen": "en",
+	"ummelden": "en",
+	"ummodeln": "eln",
+	"ummünzen": "en",
+	"umnähen": "en",
+	"umorganisieren": "en",
+	"umpflanzen": "en",
+	"umpflügen": "en",
+	"umpolen": "en",
+	"umprogrammieren": "en",
+	"umquartieren": "en",
+	"umrahmen": "en",
+	"umranden": "en",
+	"umranken": "en",
+	"umrechnen": "en",
+	"umreissen": "en",
+	"umreiten": "en",
+	"umrennen": "en",
+	"umrunden": "en",
+	"umräumen": "en",
+	"umrühren": "en",
+	"umrüsten": "en",
+	"umsatteln": "eln",
+	"umschalten": "en",
+	"umschauen": "en",
+	"umschichten": "en",
+	"umschiffen": "en",
+	"umschlagen": "en",
+	"umschliessen": "en",
+	"umschmeissen": "en",
+	"umschmieden": "en",
+	"umschnallen": "en",
+	"umschreiben": "en",
+	"umschulden": "en",
+	"umschulen": "en",
+	"umschwenken": "en",
+	"umschwärmen": "en",
+	"umschütten": "en",
+	"umsegeln": "eln",
+	"umsehen": "en",
+	"umsetzen": "en",
+	"umsiedeln": "eln",
+	"umsinken": "en",
+	"umsorgen": "en",
+	"umspannen": "en",
+	"umspielen": "en",
+	"umspringen": "en",
+	"umspulen": "en",
+	"umstechen": "en",
+	"umstehen": "en",
+	"umsteigen": "en",
+	"umstellen": "en",
+	"umsteuern": "ern",
+	"umstimmen": "en",
+	"umstossen": "en",
+	"umstrukturieren": "en",
+	"umstülpen": "en",
+	"umstürzen": "en",
+	"umsäumen": "en",
+	"umtaufen": "en",
+	"umtauschen": "en",
+	"umtopfen": "en",
+	"umtreiben": "en",
+	"umwandeln": "eln",
+	"umwandern": "ern",
+	"umwehen": "en",
+	"umwenden": "en",
+	"umwerben": "en",
+	"umwerfen": "en",
+	"umwerten": "en",
+	"umwälzen": "en",
+	"umwühlen": "en",
+	"umzeichnen": "en",
+	"umziehen": "en",
+	"umzingeln": "eln",
+	"umzäunen": "en",
+	"umändern": "ern",
+	"unangehmen": "en",
+	"ungeregelen": "en",
+	"unifizieren": "en",
+	"uniformieren": "en",
+	"unken": "en",
+	"unmotivieren": "en",
+	"unterbewerten": "en",
+	"unterbieten": "en",
+	"unterbinden": "en",
+	"unterbleiben": "en",
+	"unterbrechen": "en",
+	"unterbreiten": "en",
+	"unterbringen": "en",
+	"unterbuttern": "ern",
+	"unterdrücken": "en",
+	"unterfallen": "en",
+	"unterfassen": "en",
+	"unterfinanzieren": "en",
+	"untergehen": "en",
+	"untergliedern": "ern",
+	"untergraben": "en",
+	"unterhaken": "en",
+	"unterhalten": "en",
+	"unterjochen": "en",
+	"unterjubeln": "eln",
+	"unterkellern": "ern",
+	"unterkommen": "en",
+	"unterkriechen": "en",
+	"unterkriegen": "en",
+	"unterkühlen": "en",
+	"unterlassen": "en",
+	"unterlaufen": "en",
+	"unterlegen": "en",
+	"unterliegen": "en",
+	"untermalen": "en",
+	"untermauern": "ern",
+	"untermengen": "en",
+	"unterminieren": "en",
+	"untermischen": "en",
+	"unternehmen": "en",
+	"unterordnen": "en",
+	"unterpflügen": "en",
+	"unterqueren": "en",
+	"unterrichten": "en",
+	"untersagen": "en",
+	"unterscheiden": "en",
+	"unterschieben": "en",
+	"unterschlagen": "en",
+	"unterschlüpfen": "en",
+	"unterschreiben": "en",
+	"unterschreiten": "en",
+	"unterschätzen": "en",
+	"untersetzen": "en",
+	"untersinken": "en",
+	"unterspülen": "en",
+	"unterstehen": "en",
+	"unterstellen": "en",
+	"unterstreichen": "en",
+	"unterstreuen": "en",
+	"unterstützen": "en",
+	"untersuchen": "en",
+	"untertauchen": "en",
+	"unterteilen": "en",
+	"untertreiben": "en",
+	"untertreten": "en",
+	"untertunneln": "eln",
+	"untervermieten": "en",
+	"unterversichern": "ern",
+	"unterwandern": "ern",
+	"unterweisen": "en",
+	"unterwerfen": "en",
+	"unterwühlen": "en",
+	"unterzeichnen": "en",
+	"unterziehen": "en",
+	"uraufführen": "en",
+	"urbanisieren": "en",
+	"urinieren": "en",
+	"urteilen": "en",
+	"usurpieren": "en",
+	"utilisieren": "en",
+	"vagabundieren": "en",
+	"variieren": "en",
+	"vegetieren": "en",
+	"verabreden": "en",
+	"verabreichen": "en",
+	"verabscheuen": "en",
+	"verabschieden": "en",
+	"verabsolutieren": "en",
+	"verachten": "en",
+	"veralbern": "ern",
+	"verallgemeinern": "ern",
+	"veralten": "en",
+	"veraltern": "ern",
+	"verankern": "ern",
+	"veranlagen": "en",
+	"veranlassen": "en",
+	"veranschaulichen": "en",
+	"veranschlagen": "en",
+	"veranstalten": "en",
+	"verantworten": "en",
+	"verarbeiten": "en",
+	"verarmen": "en",
+	"verarschen": "en",
+	"verarzten": "en",
+	"verausgaben": "en",
+	"verballern": "ern",
+	"verbandelen": "en",
+	"verbannen": "en",
+	"verbarrikadieren": "en",
+	"verbauen": "en",
+	"verbeamten": "en",
+	"verbeissen": "en",
+	"verbellen": "en",
+	"verbergen": "en",
+	"verbessern": "ern",
+	"verbeugen": "en",
+	"verbeulen": "en",
+	"verbiegen": "en",
+	"verbieten": "en",
+	"verbilligen": "en",
+	"verbinden": "en",
+	"verbitten": "en",
+	"verbittern": "ern",
+	"verblassen": "en",
+	"verbleiben": "en",
+	"verbleichen": "en",
+	"verblenden": "en",
+	"verbluten": "en",
+	"verblöden": "en",
+	"verblüffen": "en",
+	"verblühen": "en",
+	"verbohren": "en",
+	"verbraten": "en",
+	"verbrauchen": "en",
+	"verbrechen": "en",
+	"verbreiten": "en",
+	"verbreitern": "ern",
+	"verbremsen": "en",
+	"verbrennen": "en",
+	"verbringen": "en",
+	"verbrämen": "en",
+	"verbrüdern": "ern",
+	"verbrühen": "en",
+	"verbuchen": "en",
+	"verbuddeln": "eln",
+	"verbummeln": "eln",
+	"verböllern": "ern",
+	"verbünden": "en",
+	"verbürgen": "en",
+	"verbüssen": "en",
+	"verdammen": "en",
+	"verdampfen": "en",
+	"verdanken": "en",
+	"verdauen": "en",
+	"verdecken": "en",
+	"verdenken": "en",
+	"verderben": "en",
+	"verdeutlichen": "en",
+	"verdichten": "en",
+	"verdienen": "en",
+	"verdingen": "en",
+	"verdonnern": "ern",
+	"verdoppeln": "eln",
+	"verdrahten": "en",
+	"verdrecken": "en",
+	"verdrehen": "en",
+	"verdreifachen": "en",
+	"verdreschen": "en",
+	"verdribbeln": "eln",
+	"verdriessen": "en",
+	"verdrängen": "en",
+	"verdrücken": "en",
+	"verduften": "en",
+	"verdummen": "en",
+	"verdunkeln": "eln",
+	"verdunsten": "en",
+	"verdursten": "en",
+	"verdächtigen": "en",
+	"verdünnen": "en",
+	"verdünnisieren": "en",
+	"verdünsten": "en",
+	"verdüstern": "ern",
+	"verebben": "en",
+	"veredeln": "eln",
+	"verehren": "en",
+	"vereiden": "en",
+	"vereidigen": "en",
+	"vereinbaren": "en",
+	"vereinen": "en",
+	"vereinfachen": "en",
+	"vereinheitlichen": "en",
+	"vereinigen": "en",
+	"vereinnahmen": "en",
+	"vereinsamen": "en",
+	"vereinzeln": "eln",
+	"vereisen": "en",
+	"vereiteln": "eln",
+	"vereitern": "ern",
+	"verelenden": "en",
+	"verenden": "en",
+	"verengen": "en",
+	"vererben": "en",
+	"verewigen": "en",
+	"verfahren": "en",
+	"verfallen": "en",
+	"verfangen": "en",
+	"verfassen": "en",
+	"verfaulen": "en",
+	"verfechten": "en",
+	"verfehlen": "en",
+	"verfeinden": "en",
+	"verfeinern": "ern",
+	"verfertigen": "en",
+	"verfestigen": "en",
+	"verfeuern": "ern",
+	"verfilmen": "en",
+	"verflachen": "en",
+	"verflechten": "en",
+	"verfliegen": "en",
+	"verfliessen": "en",
+	"verfluchen": "en",
+	"verflüchtigen": "en",
+	"verflüssigen": "en",
+	"verfolgen": "en",
+	"verformen": "en",
+	"verfrachten": "en",
+	"verfransen": "en",
+	"verfranzen": "en",
+	"verfremden": "en",
+	"verfrühen": "en",
+	"verfugen": "en",
+	"verfummeln": "eln",
+	"verfuttern": "ern",
+	"verfälschen": "en",
+	"verfärben": "en",
+	"verfügen": "en",
+	"verführen": "en",
+	"verfüttern": "ern",
+	"vergammeln": "eln",
+	"vergasen": "en",
+	"vergeben": "en",
+	"vergegenwärtigen": "en",
+	"vergehen": "en",
+	"vergeigen": "en",
+	"vergelten": "en",
+	"vergessen": "en",
+	"vergeuden": "en",
+	"vergewaltigen": "en",
+	"vergewissern": "ern",
+	"vergiessen": "en",
+	"vergiften": "en",
+	"vergilben": "en",
+	"vergittern": "ern",
+	"verglasen": "en",
+	"vergleichen": "en",
+	"verglimmen": "en",
+	"verglühen": "en",
+	"vergnügen": "en",
+	"vergolden": "en",
+	"vergraben": "en",
+	"vergraulen": "en",
+	"vergreifen": "en",
+	"vergrämen": "en",
+	"vergröbern": "ern",
+	"vergrössern": "ern",
+	"vergucken": "en",
+	"vergällen": "en",
+	"vergönnen": "en",
+	"vergöttern": "ern",
+	"vergünstigen": "en",
+	"vergüten": "en",
+	"verhaften": "en",
+	"verhageln": "eln",
+	"verhaken": "en",
+	"verhallen": "en",
+	"verhalten": "en",
+	"verhandeln": "eln",
+	"verharmlosen": "en",
+	"verharren": "en",
+	"verhaspeln": "eln",
+	"verhauen": "en",
+	"verheben": "en",
+	"verheddern": "ern",
+	"verheeren": "en",
+	"verhehlen": "en",
+	"verheilen": "en",
+	"verheimlichen": "en",
+	"verheiraten": "en",
+	"verheissen": "en",
+	"verheizen": "en",
+	"verhelfen": "en",
+	"verherrlichen": "en",
+	"verhexen": "en",
+	"verhindern": "ern",
+	"verhohnepiepeln": "eln",
+	"verhungern": "ern",
+	"verhunzen": "en",
+	"verhängen": "en",
+	"verhärten": "en",
+	"verhätscheln": "eln",
+	"verhöhnen": "en",
+	"verhökern": "ern",
+	"verhören": "en",
+	"verhüllen": "en",
+	"verhüten": "en",
+	"verhütten": "en",
+	"verinnerlichen": "en",
+	"verirren": "en",
+	"verjagen": "en",
+	"verjubeln": "eln",
+	"verjähren": "en",
+	"verjüngen": "en",
+	"verkabeln": "eln",
+	"verkacheln": "eln",
+	"verkalken": "en",
+	"verkalkulieren": "en",
+	"verkanten": "en",
+	"verkappen": "en",
+	"verkaufen": "en",
+	"verkehren": "en",
+	"verkeilen": "en",
+	"verkennen": "en",
+	"verketten": "en",
+	"verkitten": "en",
+	"verklagen": "en",
+	"verklammern": "ern",
+	"verklappen": "en",
+	"verklauseln": "eln",
+	"verkleben": "en",
+	"verkleiden": "en",
+	"verkleinern": "ern",
+	"verkleistern": "ern",
+	"verklemmen": "en",
+	"verklickern": "ern",
+	"verklingen": "en",
+	"verkloppen": "en",
+	"verklumpen": "en",
+	"verklären": "en",
+	"verklüngeln": "eln",
+	"verknacken": "en",
+	"verknacksen": "en",
+	"verknallen": "en",
+	"verknappen": "en",
+	"verkneifen": "en",
+	"verkneten": "en",
+	"verknittern": "ern",
+	"verknorpeln": "eln",
+	"verknoten": "en",
+	"verknüpfen": "en",
+	"verkohlen": "en",
+	"verkokeln": "eln",
+	"verkommen": "en",
+	"verkonsumieren": "en",
+	"verkorksen": "en",
+	"verkosten": "en",
+	"verkrachen": "en",
+	"verkraften": "en",
+	"verkrampfen": "en",
+	"verkratzen": "en",
+	"verkriechen": "en",
+	"verkrusten": "en",
+	"verkrümeln": "eln",
+	"verkrümmen": "en",
+	"verkrüppeln": "eln",
+	"verkuppeln": "eln",
+	"verkämpfen": "en",
+	"verkörpern": "ern",
+	"verköstigen": "en",
+	"verkühlen": "en",
+	"verkümmern": "ern",
+	"verkünden": "en",
+	"verkündigen": "en",
+	"verkürzen": "en",
+	"verladen": "en",
+	"verlagern": "ern",
+	"verlangen": "en",
+	"verlangsamen": "en",
+	"verlassen": "en",
+	"verlaufen": "en",
+	"verlautbaren": "en",
+	"verlauten": "en",
+	"verleben": "en",
+	"verlegen": "en",
+	"verleiben": "en",
+	"verleihen": "en",
+	"verleimen": "en",
+	"verleiten": "en",
+	"verlernen": "en",
+	"verlesen": "en",
+	"verletzen": "en",
+	"verleugnen": "en",
+	"verleumden": "en",
+	"verlieben": "en",
+	"verlieren": "en",
+	"verloben": "en",
+	"verlocken": "en",
+	"verlorengehen": "en",
+	"verlosen": "en",
+	"verlottern": "ern",
+	"verludern": "ern",
+	"verlängern": "ern",
+	"verlöschen": "en",
+	"verlöten": "en",
+	"vermachen": "en",
+	"vermalen": "en",
+	"vermampfen": "en",
+	"vermarkten": "en",
+	"vermasseln": "eln",
+	"vermehren": "en",
+	"vermeiden": "en",
+	"vermeinen": "en",
+	"vermelden": "en",
+	"vermengen": "en",
+	"vermenschlichen": "en",
+	"vermerken": "en",
+	"vermessen": "en",
+	"vermiefen": "en",
+	"vermiesen": "en",
+	"vermieten": "en",
+	"vermimen": "en",
+	"vermindern": "ern",
+	"verminen": "en",
+	"vermischen": "en",
+	"vermissen": "en",
+	"vermitteln": "eln",
+	"vermummen": "en",
+	"vermurksen": "en",
+	"vermuten": "en",
+	"vermählen": "en",
+	"vermöbeln": "eln",
+	"vermögen": "en",
+	"vernachlässigen": "en",
+	"vernageln": "eln",
+	"vernarben": "en",
+	"vernarren": "en",
+	"vernaschen": "en",
+	"vernebeln": "eln",
+	"vernehmen": "en",
+	"verneigen": "en",
+	"verneinen": "en",
+	"vernetzen": "en",
+	"vernichten": "en",
+	"verniedlichen": "en",
+	"vernieten": "en",
+	"vernähen": "en",
+	"verordnen": "en",
+	"verpachten": "en",
+	"verpacken": "en",
+	"verpassen": "en",
+	"verpatzen": "en",
+	"verpennen": "en",
+	"verpesten": "en",
+	"verpetzen": "en",
+	"verpfeffern": "ern",
+	"verpfeifen": "en",
+	"verpflanzen": "en",
+	"verpflegen": "en",
+	"verpflichten": "en",
+	"verpfuschen": "en",
+	"verpfänden": "en",
+	"verpissen": "en",
+	"verplanen": "en",
+	"verplappern": "ern",
+	"verplaudern": "ern",
+	"verplempern": "ern",
+	"verplomben": "en",
+	"verpokern": "ern",
+	"verprassen": "en",
+	"verprellen": "en",
+	"verproviantieren": "en",
+	"verprügeln": "eln",
+	"verpuffen": "en",
+	"verpulvern": "ern",
+	"verpuppen": "en",
+	"verputzen": "en",
+	"verpönen": "en",
+	"verqualmen": "en",
+	"verquatschen": "en",
+	"verquirlen": "en",
+	"verrammeln": "eln",
+	"verramschen": "en",
+	"verraten": "en",
+	"verrauschen": "en",
+	"verrechnen": "en",
+	"verregnen": "en",
+	"verreiben": "en",
+	"verreisen": "en",
+	"verreissen": "en",
+	"verrenken": "en",
+	"verrennen": "en",
+	"verrichten": "en",
+	"verriegeln": "eln",
+	"verringern": "ern",
+	"verrinnen": "en",
+	"verrosten": "en",
+	"verrotten": "en",
+	"verrutschen": "en",
+	"verräuchern": "ern",
+	"verrücken": "en",
+	"verrühren": "en",
+	"versachlichen": "en",
+	"versagen": "en",
+	"versalzen": "en",
+	"versammeln": "eln",
+	"versanden": "en",
+	"versaubeutelen": "en",
+	"versauen": "en",
+	"versauern": "ern",
+	"versaufen": "en",
+	"verschachern": "ern",
+	"verschachteln": "eln",
+	"verschaffen": "en",
+	"verschalen": "en",
+	"verschalten": "en",
+	"verschandeln": "eln",
+	"verschanzen": "en",
+	"verscharren": "en",
+	"verschatten": "en",
+	"verschaukeln": "eln",
+	"verscheiden": "en",
+	"verscheissern": "ern",
+	"verschenken": "en",
+	"verscherbeln": "eln",
+	"verscherzen": "en",
+	"verscheuchen": "en",
+	"verscheuern": "ern",
+	"verschicken": "en",
+	"verschieben": "en",
+	"verschiessen": "en",
+	"verschiffen": "en",
+	"verschimmeln": "eln",
+	"verschlafen": "en",
+	"verschlagen": "en",
+	"verschlammen": "en",
+	"verschlampen": "en",
+	"verschlanken": "en",
+	"verschlechtern": "ern",
+	"verschleiern": "ern",
+	"verschleimen": "en",
+	"verschleissen": "en",
+	"verschleppen": "en",
+	"verschleudern": "ern",
+	"verschliessen": "en",
+	"verschlimmbessern": "ern",
+	"verschlimmern": "ern",
+	"verschlingen": "en",
+	"verschlucken": "en",
+	"verschludern": "ern",
+	"verschlüsseln": "eln",
+	"verschmelzen": "en",
+	"verschmerzen": "en",
+	"verschmieren": "en",
+	"verschmutzen": "en",
+	"verschmähen": "en",
+	"verschnaufen": "en",
+	"verschneiden": "en",
+	"verschneien": "en",
+	"verschnupfen": "en",
+	"verschnörkeln": "eln",
+	"verschnüren": "en",
+	"verschonen": "en",
+	"verschrammen": "en",
+	"verschrauben": "en",
+	"verschrecken": "en",
+	"verschreiben": "en",
+	"verschreien": "en",
+	"verschrotten": "en",
+	"verschrumpeln": "eln",
+	"verschränken": "en",
+	"verschulden": "en",
+	"verschwatzen": "en",
+	"verschweigen": "en",
+	"verschweissen": "en",
+	"verschwenden": "en",
+	"verschwimmen": "en",
+	"verschwinden": "en",
+	"verschwitzen": "en",
+	"verschwägern": "ern",
+	"verschwören": "en",
+	"verschärfen": "en",
+	"verschätzen": "en",
+	"verschönern": "ern",
+	"verschüchtern": "ern",
+	"verschütten": "en",
+	"verschüttgehen": "en",
+	"versegeln": "eln",
+	"versehen": "en",
+	"versehren": "en",
+	"verselbständigen": "en",
+	"versenden": "en",
+	"versenken": "en",
+	"versetzen": "en",
+	"verseuchen": "en",
+	"versichern": "ern",
+	"versickern": "ern",
+	"versieben": "en",
+	"versiebenfachen": "en",
+	"versiechen": "en",
+	"versiegeln": "eln",
+	"versiegen": "en",
+	"versilbern": "ern",
+	"versinken": "en",
+	"versinnbildlichen": "en",
+	"versittlichen": "en",
+	"versklaven": "en",
+	"versohlen": "en",
+	"versorgen": "en",
+	"verspachteln": "eln",
+	"verspannen": "en",
+	"verspeisen": "en",
+	"verspekulieren": "en",
+	"versperren": "en",
+	"verspielen": "en",
+	"verspotten": "en",
+	"versprechen": "en",
+	"verspringen": "en",
+	"verspritzen": "en",
+	"versprühen": "en",
+	"verspäten": "en",
+	"verspüren": "en",
+	"verstaatlichen": "en",
+	"verstauben": "en",
+	"verstauchen": "en",
+	"verstauen": "en",
+	"verstecken": "en",
+	"verstehen": "en",
+	"versteifen": "en",
+	"versteigen": "en",
+	"versteigern": "ern",
+	"versteinern": "ern",
+	"verstellen": "en",
+	"versterben": "en",
+	"versteuern": "ern",
+	"verstimmen": "en",
+	"verstopfen": "en",
+	"verstossen": "en",
+	"verstrahlen": "en",
+	"verstreichen": "en",
+	"verstreuen": "en",
+	"verstricken": "en",
+	"verstromen": "en",
+	"verströmen": "en",
+	"verstummen": "en",
+	"verständigen": "en",
+	"verstänkern": "ern",
+	"verstärken": "en",
+	"verstückeln": "eln",
+	"verstümmeln": "eln",
+	"versuchen": "en",
+	"versumpfen": "en",
+	"versäbeln": "eln",
+	"versäumen": "en",
+	"versöhnen": "en",
+	"versühnen": "en",
+	"versündigen": "en",
+	"versüssen": "en",
+	"vertagen": "en",
+	"vertauschen": "en",
+	"verteidigen": "en",
+	"verteilen": "en",
+	"verteuern": "ern",
+	"verteufeln": "eln",
+	"vertiefen": "en",
+	"vertilgen": "en",
+	"vertippen": "en",
+	"vertonen": "en",
+	"vertragen": "en",
+	"vertrauen": "en",
+	"vertreiben": "en",
+	"vertreten": "en",
+	"vertrimmen": "en",
+	"vertrocknen": "en",
+	"verträumen": "en",
+	"vertrödeln": "eln",
+	"vertrösten": "en",
+	"vertuen": "en",
+	"vertuschen": "en",
+	"vertäfeln": "eln",
+	"vertändeln": "eln",
+	"verulken": "en",
+	"verunfallen": "en",
+	"verunglimpfen": "en",
+	"verunglücken": "en",
+	"verunreinigen": "en",
+	"verunsichern": "ern",
+	"verunstalten": "en",
+	"veruntreuen": "en",
+	"verursachen": "en",
+	"verurteilen": "en",
+	"vervielfachen": "en",
+	"vervielfältigen": "en",
+	"vervierfachen": "en",
+	"vervollkommnen": "en",
+	"vervollständigen": "en",
+	"verwachsen": "en",
+	"verwahren": "en",
+	"verwahrlosen": "en",
+	"verwaisen": "en",
+	"verwalten": "en",
+	"verwandeln": "eln",
+	"verwanden": "en",
+	"verwarnen": "en",
+	"verweben": "en",
+	"verwechseln": "eln",
+	"verwehen": "en",
+	"verwehren": "en",
+	"verweichlichen": "en",
+	"verweigern": "ern",
+	"verweilen": "en",
+	"verweisen": "en",
+	"verwelken": "en",
+	"verweltlichen": "en",
+	"verwenden": "en",
+	"verwerfen": "en",
+	"verwerten": "en",
+	"verwesen": "en",
+	"verwetten": "en",
+	"verwickeln": "eln",
+	"verwinden": "en",
+	"verwinkeln": "eln",
+	"verwirklichen": "en",
+	"verwirren": "en",
+	"verwirtschaften": "en",
+	"verwischen": "en",
+	"verwohnen": "en",
+	"verwuchern": "ern",
+	"verwunden": "en",
+	"verwundern": "ern",
+	"verwursteln": "eln",
+	"verwursten": "en",
+	"verwurzeln": "eln",
+	"verwählen": "en",
+	"verwähren": "en",
+	"verwässern": "ern",
+	"verwöhnen": "en",
+	"verwünschen": "en",
+	"verwüsten": "en",
+	"verzagen": "en",
+	"verzahnen": "en",
+	"verzapfen": "en",
+	"verzaubern": "ern",
+	"verzehren": "en",
+	"verzeichnen": "en",
+	"verzeihen": "en",
+	"verzerren": "en",
+	"verzetteln": "eln",
+	"verzichten": "en",
+	"verziehen": "en",
+	"verzieren": "en",
+	"verzinken": "en",
+	"verzinsen": "en",
+	"verzollen": "en",
+	"verzweifeln": "eln",
+	"verzweigen": "en",
+	"verzählen": "en",
+	"verzögern": "ern",
+	"verändern": "ern",
+	"verängstigen": "en",
+	"veräppeln": "eln",
+	"verärgern": "ern",
+	"verästeln": "eln",
+	"veräussern": "ern",
+	"veröden": "en",
+	"veröffentlichen": "en",
+	"verübeln": "eln",
+	"verüben": "en",
+	"vierteilen": "en",
+	"vierteln": "eln",
+	"visieren": "en",
+	"vollautomatisieren": "en",
+	"vollbringen": "en",
+	"vollenden": "en",
+	"vollessen": "en",
+	"vollführen": "en",
+	"vollfüllen": "en",
+	"vollgiessen": "en",
+	"vollkritzeln": "eln",
+	"volllabern": "ern",
+	"vollmachen": "en",
+	"vollmalen": "en",
+	"vollpfropfen": "en",
+	"vollquatschen": "en",
+	"vollschlagen": "en",
+	"vollschmieren": "en",
+	"vollschreiben": "en",
+	"vollspritzen": "en",
+	"vollstopfen": "en",
+	"vollstrecken": "en",
+	"volltanken": "en",
+	"vollziehen": "en",
+	"voranbringen": "en",
+	"vorangehen": "en",
+	"vorankommen": "en",
+	"voranschreiten": "en",
+	"voranstellen": "en",
+	"vorantreiben": "en",
+	"vorarbeiten": "en",
+	"vorausberechnen": "en",
+	"vorausblicken": "en",
+	"vorausgehen": "en",
+	"voraushaben": "en",
+	"voraussagen": "en",
+	"vorausschauen": "en",
+	"voraussehen": "en",
+	"voraussetzen": "en",
+	"vorbauen": "en",
+	"vorbehalten": "en",
+	"vorbehandeln": "eln",
+	"vorbeibenehmen": "en",
+	"vorbeifahren": "en",
+	"vorbeifliegen": "en",
+	"vorbeifliessen": "en",
+	"vorbeiführen": "en",
+	"vorbeigehen": "en",
+	"vorbeijonglieren": "en",
+	"vorbeikommen": "en",
+	"vorbeilassen": "en",
+	"vorbeilaufen": "en",
+	"vorbeimarschieren": "en",
+	"vorbeirasen": "en",
+	"vorbeireden": "en",
+	"vorbeirennen": "en",
+	"vorbeischauen": "en",
+	"vorbeischiessen": "en",
+	"vorbeischleichen": "en",
+	"vorbeistehlen": "en",
+	"vorbeitreffen": "en",
+	"vorbeiziehen": "en",
+	"vorbereiten": "en",
+	"vorbestellen": "en",
+	"vorbeten": "en",
+	"vorbeugen": "en",
+	"vorbilden": "en",
+	"vorbinden": "en",
+	"vorbleichen": "en",
+	"vorbohren": "en",
+	"vorbringen": "en",
+	"vordatieren": "en",
+	"vordenken": "en",
+	"vordringen": "en",
+	"vordrängen": "en",
+	"vorenthalten": "en",
+	"vorerzählen": "en",
+	"vorfahren": "en",
+	"vorfallen": "en",
+	"vorfertigen": "en",
+	"vorfinanzieren": "en",
+	"vorfinden": "en",
+	"vorflunkern": "ern",
+	"vorformen": "en",
+	"vorformulieren": "en",
+	"vorfühlen": "en",
+	"vorführen": "en",
+	"vorgaukeln": "eln",
+	"vorgeben": "en",
+	"vorgehen": "en",
+	"vorgreifen": "en",
+	"vorhalten": "en",
+	"vorharren": "en",
+	"vorherfahren": "en",
+	"vorherrschen": "en",
+	"vorhersagen": "en",
+	"vorhersehen": "en",
+	"vorherspringen": "en",
+	"vorheucheln": "eln",
+	"vorheulen": "en",
+	"vorhängen": "en",
+	"vorjammern": "ern",
+	"vorkauen": "en",
+	"vorkehren": "en",
+	"vorkeimen": "en",
+	"vorklären": "en",
+	"vorknöpfen": "en",
+	"vorkommen": "en",
+	"vorladen": "en",
+	"vorlaufen": "en",
+	"vorleben": "en",
+	"vorlegen": "en",
+	"vorlesen": "en",
+	"vorliebnehmen": "en",
+	"vorliegen": "en",
+	"vorlügen": "en",
+	"vormachen": "en",
+	"vormalen": "en",
+	"vormerken": "en",
+	"vornaschen": "en",
+	"vornehmen": "en",
+	"vorneigen": "en",
+	"vorordnen": "en",
+	"vorpreschen": "en",
+	"vorprogrammieren": "en",
+	"vorquellen": "en",
+	"vorrechnen": "en",
+	"vorreden": "en",
+	"vorreiten": "en",
+	"vorrichten": "en",
+	"vorrücken": "en",
+	"vorsagen": "en",
+	"vorschieben": "en",
+	"vorschiessen": "en",
+	"vorschlagen": "en",
+	"vorschneiden": "en",
+	"vorschreiben": "en",
+	"vorschreiten": "en",
+	"vorschweben": "en",
+	"vorschwindeln": "eln",
+	"vorschwärmen": "en",
+	"vorschützen": "en",
+	"vorsehen": "en",
+	"vorsetzen": "en",
+	"vorsingen": "en",
+	"vorsitzen": "en",
+	"vorsorgen": "en",
+	"vorspannen": "en",
+	"vorspiegeln": "eln",
+	"vorspielen": "en",
+	"vorsprechen": "en",
+	"vorspringen": "en",
+	"vorspulen": "en",
+	"vorstehen": "en",
+	"vorstellen": "en",
+	"vorstossen": "en",
+	"vorstülpen": "en",
+	"vorstürmen": "en",
+	"vortanzen": "en",
+	"vortragen": "en",
+	"vortreiben": "en",
+	"vortreten": "en",
+	"vorturnen": "en",
+	"vortäuschen": "en",
+	"vorverlegen": "en",
+	"vorverurteilen": "en",
+	"vorwagen": "en",
+	"vorwarnen": "en",
+	"vorwegnehmen": "en",
+	"vorweisen": "en",
+	"vorwerfen": "en",
+	"vorwiegen": "en",
+	"vorwählen": "en",
+	"vorwärmen": "en",
+	"vorwärtsgehen": "en",
+	"vorwärtskommen": "en",
+	"vorwärtsschreiten": "en",
+	"vorwölben": "en",
+	"vorzaubern": "ern",
+	"vorzeichnen": "en",
+	"vorzeigen": "en",
+	"vorziehen": "en",
+	"vorzählen": "en",
+	"vorüberbewegen": "en",
+	"vorübergehen": "en",
+	"vorüberschweben": "en",
+	"vorüberziehen": "en",
+	"votieren": "en",
+	"vögeln": "eln",
+	"wabbeln": "eln",
+	"wabern": "ern",
+	"wachen": "en",
+	"wachhalten": "en",
+	"wachrufen": "en",
+	"wachrütteln": "eln",
+	"wachsen": "en",
+	"wackeln": "eln",
+	"waffnen": "en",
+	"wagen": "en",
+	"wahren": "en",
+	"wahrhaben": "en",
+	"wahrmachen": "en",
+	"wahrnehmen": "en",
+	"wahrsagen": "en",
+	"wahrzumachen": "en",
+	"wallen": "en",
+	"walten": "en",
+	"walzen": "en",
+	"wandeln": "eln",
+	"wandern": "ern",
+	"wanken": "en",
+	"wappnen": "en",
+	"warmhalten": "en",
+	"warnen": "en",
+	"warten": "en",
+	"waschen": "en",
+	"waten": "en",
+	"watscheln": "eln",
+	"weben": "en",
+	"wechseln": "eln",
+	"wecken": "en",
+	"wedeln": "eln",
+	"wegbekommen": "en",
+	"wegblasen": "en",
+	"wegbleiben": "en",
+	"wegblicken": "en",
+	"wegbrechen": "en",
+	"wegbrennen": "en",
+	"wegbringen": "en",
+	"wegdenken": "en",
+	"wegdrücken": "en",
+	"wegessen": "en",
+	"wegfahren": "en",
+	"wegfallen": "en",
+	"wegfangen": "en",
+	"wegfegen": "en",
+	"wegfliegen": "en",
+	"wegfliessen": "en",
+	"wegfressen": "en",
+	"wegführen": "en",
+	"weggeben": "en",
+	"weggehen": "en",
+	"weghalten": "en",
+	"wegheben": "en",
+	"wegholen": "en",
+	"weghängen": "en",
+	"wegjagen": "en",
+	"wegkaufen": "en",
+	"wegkehren": "en",
+	"wegkommen": "en",
+	"wegkratzen": "en",
+	"wegkürzen": "en",
+	"weglassen": "en",
+	"weglaufen": "en",
+	"weglegen": "en",
+	"weglocken": "en",
+	"wegmachen": "en",
+	"wegmarschieren": "en",
+	"wegnehmen": "en",
+	"wegradieren": "en",
+	"wegraffen": "en",
+	"wegrationalisieren": "en",
+	"wegreissen": "en",
+	"wegrollen": "en",
+	"wegrufen": "en",
+	"wegräumen": "en",
+	"wegsaufen": "en",
+	"wegschaffen": "en",
+	"wegschauen": "en",
+	"wegschaufeln": "eln",
+	"wegschenken": "en",
+	"wegscheren": "en",
+	"wegschicken": "en",
+	"wegschieben": "en",
+	"wegschleichen": "en",
+	"wegschliessen": "en",
+	"wegschmeissen": "en",
+	"wegschmelzen": "en",
+	"wegschnappen": "en",
+	"wegschneiden": "en",
+	"wegschütten": "en",
+	"wegsehen": "en",
+	"wegsetzen": "en",
+	"wegspielen": "en",
+	"wegsprengen": "en",
+	"wegspülen": "en",
+	"wegstecken": "en",
+	"wegstehlen": "en",
+	"wegsterben": "en",
+	"wegstossen": "en",
+	"wegstreichen": "en",
+	"wegtauchen": "en",
+	"wegtragen": "en",
+	"wegtreiben": "en",
+	"wegtreten": "en",
+	"wegtrinken": "en",
+	"wegwaschen": "en",
+	"wegwenden": "en",
+	"wegwerfen": "en",
+	"wegwischen": "en",
+	"wegzappen": "en",
+	"wegzaubern": "ern",
+	"wegzerren": "en",
+	"wegziehen": "en",
+	"wehen": "en",
+	"wehren": "en",
+	"wehtun": "n",
+	"weichen": "en",
+	"weiden": "en",
+	"weigern": "ern",
+	"weihen": "en",
+	"weihnachten": "en",
+	"weilen": "en",
+	"weinen": "en",
+	"weisen": "en",
+	"weismachen": "en",
+	"weissagen": "en",
+	"weissen": "en",
+	"weiten": "en",
+	"weiterarbeiten": "en",
+	"weiterbauen": "en",
+	"weiterbeschäftigen": "en",
+	"weiterbestehen": "en",
+	"weiterbilden": "en",
+	"weiterbringen": "en",
+	"weiterdebattieren": "en",
+	"weiterdenken": "en",
+	"weiterempfehlen": "en",
+	"weiterentwickeln": "eln",
+	"weitererzählen": "en",
+	"weiterfahren": "en",
+	"weiterfliegen": "en",
+	"weiterführen": "en",
+	"weitergeben": "en",
+	"weitergehen": "en",
+	"weitergelten": "en",
+	"weiterhelfen": "en",
+	"weiterkommen": "en",
+	"weiterlancieren": "en",
+	"weiterlaufen": "en",
+	"weiterleiten": "en",
+	"weitermachen": "en",
+	"weitermarschieren": "en",
+	"weiterplazieren": "en",
+	"weiterproduzieren": "en",
+	"weiterreisen": "en",
+	"weitersagen": "en",
+	"weiterschicken": "en",
+	"weitersehen": "en",
+	"weiterspinnen": "en",
+	"weitertragen": "en",
+	"weitertransportieren": "en",
+	"weitertreiben": "en",
+	"weiterverbreiten": "en",
+	"weiterverfolgen": "en",
+	"weiterverkaufen": "en",
+	"weitervermieten": "en",
+	"weitervermitteln": "eln",
+	"weiterwälzen": "en",
+	"weiterzahlen": "en",
+	"weiterziehen": "en",
+	"welken": "en",
+	"wellen": "en",
+	"wenden": "en",
+	"werben": "en",
+	"werden": "en",
+	"werfen": "en",
+	"werkeln": "eln",
+	"werken": "en",
+	"werten": "en",
+	"wetteifern": "ern",
+	"wetten": "en",
+	"wettern": "ern",
+	"wettmachen": "en",
+	"wetzen": "en",
+	"wichteln": "eln",
+	"wickeln": "eln",
+	"widerfahren": "en",
+	"widerhallen": "en",
+	"widerklingen": "en",
+	"widerlegen": "en",
+	"widern": "ern",
+	"widerrufen": "en",
+	"widerscheinen": "en",
+	"widersetzen": "en",
+	"widerspiegeln": "eln",
+	"widersprechen": "en",
+	"widerstehen": "en",
+	"widerstreben": "en",
+	"widmen": "en",
+	"wiederaufarbeiten": "en",
+	"wiederaufnehmen": "en",
+	"wiederauftauchen": "en",
+	"wiederbegegnen": "en",
+	"wiederbekommen": "en",
+	"wiederbeleben": "en",
+	"wiederbringen": "en",
+	"wiederentstehen": "en",
+	"wiederergreifen": "en",
+	"wiedererhalten": "en",
+	"wiedererkennen": "en",
+	"wiedererlangen": "en",
+	"wiedererstatten": "en",
+	"wiedererstehen": "en",
+	"wiedererzählen": "en",
+	"wiederfinden": "en",
+	"wiedergeben": "en",
+	"wiedergewinnen": "en",
+	"wiedergründen": "en",
+	"wiedergutmachen": "en",
+	"wiederhaben": "en",
+	"wiederherstellen": "en",
+	"wiederholen": "en",
+	"wiederimpfen": "en",
+	"wiederkehren": "en",
+	"wiederkommen": "en",
+	"wiederkriegen": "en",
+	"wiederkäuen": "en",
+	"wiederlieben": "en",
+	"wiedersagen": "en",
+	"wiedersehen": "en",
+	"wiedervereinigen": "en",
+	"wiederverkaufen": "en",
+	"wiederwählen": "en",
+	"wiegeln": "eln",
+	"wiegen": "en",
+	"wiehern": "ern",
+	"wienern": "ern",
+	"wildern": "ern",
+	"willigen": "en",
+	"wimmeln": "eln",
+	"wimmern": "ern",
+	"winden": "en",
+	"winkeln": "eln",
+	"winken": "en",
+	"winseln": "eln",
+	"winzeren": "en",
+	"wippen": "en",
+	"wirbeln": "eln",
+	"wirken": "en",
+	"wirten": "en",
+	"wirtschaften": "en",
+	"wischen": "en",
+	"wispern": "ern",
+	"wissen": "en",
+	"wittern": "ern",
+	"witzeln": "eln",
+	"wohlverhalten": "en",
+	"wohnen": "en",
+	"wollen": "en",
+	"wordenen": "en",
+	"wringen": "en",
+	"wuchern": "ern",
+	"wuchten": "en",
+	"wundern": "ern",
+	"wurmen": "en",
+	"wursteln": "eln",
+	"wursten": "en",
+	"wurzeln": "eln",
+	"wuseln": "eln",
+	"wägen": "en",
+	"wählen": "en",
+	"wähnen": "en",
+	"währen": "en",
+	"wälzen": "en",
+	"wärmen": "en",
+	"wässern": "ern",
+	"wölben": "en",
+	"wühlen": "en",
+	"wünschen": "en",
+	"würdigen": "en",
+	"würfeln": "eln",
+	"würgen": "en",
+	"würzen": "en",
+	"wüten": "en",
+	"zagen": "en",
+	"zahlen": "en",
+	"zahnen": "en",
+	"zanken": "en",
+	"zapfen": "en",
+	"zappeln": "eln",
+	"zaubern": "ern",
+	"zaudern": "ern",
+	"zausen": "en",
+	"zechen": "en",
+	"zehren": "en",
+	"zeichnen": "en",
+	"zeigen": "en",
+	"zeihen": "en",
+	"zeitigen": "en",
+	"zelebrieren": "en",
+	"zelten": "en",
+	"zementieren": "en",
+	"zensieren": "en",
+	"zentralisieren": "en",
+	"zentrieren": "en",
+	"zentrifugieren": "en",
+	"zerbeissen": "en",
+	"zerbeulen": "en",
+	"zerbiegen": "en",
+	"zerblasen": "en",
+	"zerbomben": "en",
+	"zerbrechen": "en",
+	"zerbröckeln": "eln",
+	"zerbröseln": "eln",
+	"zerdampfen": "en",
+	"zerdellen": "en",
+	"zerdonnern": "ern",
+	"zerdröseln": "eln",
+	"zerdrücken": "en",
+	"zerfallen": "en",
+	"zerfasern": "ern",
+	"zerfetzen": "en",
+	"zerflattern": "ern",
+	"zerfleddern": "ern",
+	"zerfleischen": "en",
+	"zerfliessen": "en",
+	"zerfransen": "en",
+	"zerfressen": "en",
+	"zergehen": "en",
+	"zergliedern": "ern",
+	"zerhacken": "en",
+	"zerhageln": "eln",
+	"zerhauen": "en",
+	"zerhäckseln": "eln",
+	"zerkauen": "en",
+	"zerkleben": "en",
+	"zerkleinern": "ern",
+	"zerklumpen": "en",
+	"zerknacken": "en",
+	"zerknallen": "en",
+	"zerknautschen": "en",
+	"zerkneifen": "en",
+	"zerknirschen": "en",
+	"zerknittern": "ern",
+	"zerknüllen": "en",
+	"zerkochen": "en",
+	"zerkrachen": "en",
+	"zerkratzen": "en",
+	"zerkräuseln": "eln",
+	"zerkrümeln": "eln",
+	"zerlatschen": "en",
+	"zerlaufen": "en",
+	"zerlegen": "en",
+	"zermahlen": "en",
+	"zermalmen": "en",
+	"zermartern": "ern",
+	"zermetzeln": "eln",
+	"zermürben": "en",
+	"zernagen": "en",
+	"zernarben": "en",
+	"zerpfeifen": "en",
+	"zerpflücken": "en",
+	"zerpflügen": "en",
+	"zerpicken": "en",
+	"zerplatzen": "en",
+	"zerpressen": "en",
+	"zerquetschen": "en",
+	"zerreden": "en",
+	"zerreiben": "en",
+	"zerreissen": "en",
+	"zerren": "en",
+	"zerrinnen": "en",
+	"zerrupfen": "en",
+	"zerrütteln": "eln",
+	"zerrütten": "en",
+	"zersalzen": "en",
+	"zerschellen": "en",
+	"zerscheren": "en",
+	"zerscheuern": "ern",
+	"zerschiessen": "en",
+	"zerschinden": "en",
+	"zerschlagen": "en",
+	"zerschmeissen": "en",
+	"zerschmelzen": "en",
+	"zerschmettern": "ern",
+	"zerschneiden": "en",
+	"zersetzen": "en",
+	"zersiedeln": "eln",
+	"zersieden": "en",
+	"zerspalten": "en",
+	"zersplittern": "ern",
+	"zersprengen": "en",
+	"zerspringen": "en",
+	"zerstampfen": "en",
+	"zerstechen": "en",
+	"zerstreiten": "en",
+	"zerstreuen": "en",
+	"zerstäuben": "en",
+	"zerstören": "en",
+	"zerstückeln": "eln",
+	"zerstümmeln": "eln",
+	"zersägen": "en",
+	"zerteilen": "en",
+	"zertrampeln": "eln",
+	"zertrennen": "en",
+	"zertreten": "en",
+	"zertrümmern": "ern",
+	"zerwühlen": "en",
+	"zerzausen": "en",
+	"zerzupfen": "en",
+	"zetteln": "eln",
+	"zeugen": "en",
+	"ziehen": "en",
+	"zielen": "en",
+	"ziemen": "en",
+	"zieren": "en",
+	"zigeunern": "ern",
+	"zimmern": "ern",
+	"zinken": "en",
+	"zirkeln": "eln",
+	"zirkulieren": "en",
+	"zischeln": "eln",
+	"zischen": "en",
+	"zitieren": "en",
+	"zittern": "ern",
+	"zollen": "en",
+	"zuarbeiten": "en",
+	"zuballern": "ern",
+	"zubauen": "en",
+	"zubeissen": "en",
+	"zubekommen": "en",
+	"zubereiten": "en",
+	"zubetonieren": "en",
+	"zubewegen": "en",
+	"zubilligen": "en",
+	"zubinden": "en",
+	"zublinzeln": "eln",
+	"zubringen": "en",
+	"zubuttern": "ern",
+	"zucken": "en",
+	"zudecken": "en",
+	"zudenken": "en",
+	"zudienen": "en",
+	"zudiktieren": "en",
+	"zudrehen": "en",
+	"zudrücken": "en",
+	"zueignen": "en",
+	"zuerkennen": "en",
+	"zufallen": "en",
+	"zufassen": "en",
+	"zufaxen": "en",
+	"zufliegen": "en",
+	"zufliessen": "en",
+	"zuflüstern": "ern",
+	"zufriedengeben": "en",
+	"zufriedenlassen": "en",
+	"zufriedenstellen": "en",
+	"zufrieren": "en",
+	"zufächeln": "eln",
+	"zufügen": "en",
+	"zuführen": "en",
+	"zugeben": "en",
+	"zugehen": "en",
+	"zugehören": "en",
+	"zugesellen": "en",
+	"zugestehen": "en",
+	"zugiessen": "en",
+	"zugreifen": "en",
+	"zugrundeliegen": "en",
+	"zugutekommen": "en",
+	"zuhaken": "en",
+	"zuhalten": "en",
+	"zuhauen": "en",
+	"zuheilen": "en",
+	"zuhängen": "en",
+	"zuhören": "en",
+	"zujauchzen": "en",
+	"zujubeln": "eln",
+	"zukaufen": "en",
+	"zukehren": "en",
+	"zuklappen": "en",
+	"zukleben": "en",
+	"zuklinken": "en",
+	"zuknallen": "en",
+	"zuknöpfen": "en",
+	"zukommen": "en",
+	"zukorken": "en",
+	"zukriegen": "en",
+	"zulachen": "en",
+	"zuladen": "en",
+	"zulangen": "en",
+	"zulassen": "en",
+	"zulaufen": "en",
+	"zulegen": "en",
+	"zuleiten": "en",
+	"zulächeln": "eln",
+	"zulöten": "en",
+	"zumachen": "en",
+	"zumauern": "ern",
+	"zumessen": "en",
+	"zumuten": "en",
+	"zunageln": "eln",
+	"zunehmen": "en",
+	"zuneigen": "en",
+	"zunähen": "en",
+	"zuordnen": "en",
+	"zupacken": "en",
+	"zupfen": "en",
+	"zupfropfen": "en",
+	"zuprosten": "en",
+	"zuraten": "en",
+	"zuraunen": "en",
+	"zurechnen": "en",
+	"zurechtbiegen": "en",
+	"zurechtfinden": "en",
+	"zurechtkommen": "en",
+	"zurechtkriegen": "en",
+	"zurechtlegen": "en",
+	"zurechtmachen": "en",
+	"zurechtrücken": "en",
+	"zurechtschustern": "ern",
+	"zurechtsetzen": "en",
+	"zurechtstutzen": "en",
+	"zurechtweisen": "en",
+	"zurechtzimmern": "ern",
+	"zureden": "en",
+	"zureichen": "en",
+	"zureiten": "en",
+	"zurichten": "en",
+	"zuriegeln": "eln",
+	"zurollen": "en",
+	"zurren": "en",
+	"zurufen": "en",
+	"zurückbegeben": "en",
+	"zurückbegleiten": "en",
+	"zurückbehalten": "en",
+	"zurückbekommen": "en",
+	"zurückberufen": "en",
+	"zurückbeugen": "en",
+	"zurückbewegen": "en",
+	"zurückbiegen": "en",
+	"zurückbilden": "en",
+	"zurückbleiben": "en",
+	"zurückblicken": "en",
+	"zurückblättern": "ern",
+	"zurückbringen": "en",
+	"zurückdatieren": "en",
+	"zurückdenken": "en",
+	"zurückdrehen": "en",
+	"zurückdrängen": "en",
+	"zurückeilen": "en",
+	"zurückerbitten": "en",
+	"zurückerhalten": "en",
+	"zurückerinnern": "ern",
+	"zurückerlangen": "en",
+	"zurückerobern": "ern",
+	"zurückerstatten": "en",
+	"zurückerwarten": "en",
+	"zurückerwerben": "en",
+	"zurückfahren": "en",
+	"zurückfallen": "en",
+	"zurückfinden": "en",
+	"zurückfliegen": "en",
+	"zurückfliessen": "en",
+	"zurückfordern": "ern",
+	"zurückfragen": "en",
+	"zurückführen": "en",
+	"zurückgeben": "en",
+	"zurückgehen": "en",
+	"zurückgelangen": "en",
+	"zurückgewinnen": "en",
+	"zurückgreifen": "en",
+	"zurückhaben": "en",
+	"zurückhalten": "en",
+	"zurückholen": "en",
+	"zurückjagen": "en",
+	"zurückkaufen": "en",
+	"zurückkehren": "en",
+	"zurückklappen": "en",
+	"zurückkommen": "en",
+	"zurückkämmen": "en",
+	"zurücklassen": "en",
+	"zurücklaufen": "en",
+	"zurücklegen": "en",
+	"zurücklehnen": "en",
+	"zurückliegen": "en",
+	"zurückmarschieren": "en",
+	"zurückmelden": "en",
+	"zurücknehmen": "en",
+	"zurückprallen": "en",
+	"zurückreichen": "en",
+	"zurückreisen": "en",
+	"zurückreissen": "en",
+	"zurückreiten": "en",
+	"zurückrollen": "en",
+	"zurückrufen": "en",
+	"zurückschaffen": "en",
+	"zurückschallen": "en",
+	"zurückschalten": "en",
+	"zurückschaudern": "ern",
+	"zurückschauen": "en",
+	"zurückscheuchen": "en",
+	"zurückscheuen": "en",
+	"zurückschicken": "en",
+	"zurückschieben": "en",
+	"zurückschlagen": "en",
+	"zurückschleudern": "ern",
+	"zurückschnellen": "en",
+	"zurückschrauben": "en",
+	"zurückschrecken": "en",
+	"zurückschreiben": "en",
+	"zurückschwimmen": "en",
+	"zurücksehnen": "en",
+	"zurücksenden": "en",
+	"zurücksetzen": "en",
+	"zurücksinken": "en",
+	"zurückspringen": "en",
+	"zurückspulen": "en",
+	"zurückstehen": "en",
+	"zurückstellen": "en",
+	"zurückstossen": "en",
+	"zurückstrahlen": "en",
+	"zurückstreichen": "en",
+	"zurückstreifen": "en",
+	"zurückströmen": "en",
+	"zurückstutzen": "en",
+	"zurücktauchen": "en",
+	"zurücktaumeln": "eln",
+	"zurücktragen": "en",
+	"zurücktreiben": "en",
+	"zurücktreten": "en",
+	"zurückverfolgen": "en",
+	"zurückverlangen": "en",
+	"zurückverlegen": "en",
+	"zurückvermieten": "en",
+	"zurückversetzen": "en",
+	"zurückverweisen": "en",
+	"zurückwandern": "ern",
+	"zurückweichen": "en",
+	"zurückweisen": "en",
+	"zurückwerfen": "en",
+	"zurückwirken": "en",
+	"zurückwünschen": "en",
+	"zurückzahlen": "en",
+	"zurückziehen": "en",
+	"zurückübersetzen": "en",
+	"zurüsten": "en",
+	"zusagen": "en",
+	"zusamenbrechen": "en",
+	"zusammenarbeiten": "en",
+	"zusammenballen": "en",
+	"zusammenbauen": "en",
+	"zusammenbeissen": "en",
+	"zusammenbetteln": "eln",
+	"zusammenbinden": "en",
+	"zusammenbleiben": "en",
+	"zusammenborgen": "en",
+	"zusammenbrauen": "en",
+	"zusammenbrechen": "en",
+	"zusammenbringen": "en",
+	"zusammenbrutzeln": "eln",
+	"zusammendrängen": "en",
+	"zusammenfahren": "en",
+	"zusammenfallen": "en",
+	"zusammenfalten": "en",
+	"zusammenfassen": "en",
+	"zusammenfegen": "en",
+	"zusammenfinden": "en",
+	"zusammenflechten": "en",
+	"zusammenfliessen": "en",
+	"zusammenfügen": "en",
+	"zusammenführen": "en",
+	"zusammengeben": "en",
+	"zusammengehen": "en",
+	"zusammengehören": "en",
+	"zusammengeraten": "en",
+	"zusammengiessen": "en",
+	"zusammenhalten": "en",
+	"zusammenhauen": "en",
+	"zusammenheften": "en",
+	"zusammenheilen": "en",
+	"zusammenholen": "en",
+	"zusammenhängen": "en",
+	"zusammenkaufen": "en",
+	"zusammenkehren": "en",
+	"zusammenketten": "en",
+	"zusammenkitten": "en",
+	"zusammenklammern": "ern",
+	"zusammenklappen": "en",
+	"zusammenkleben": "en",
+	"zusammenkleistern": "ern",
+	"zusammenklingen": "en",
+	"zusammenkneifen": "en",
+	"zusammenknüllen": "en",
+	"zusammenknüpfen": "en",
+	"zusammenkommen": "en",
+	"zusammenkoppeln": "eln",
+	"zusammenkrachen": "en",
+	"zusammenkramen": "en",
+	"zusammenkrampfen": "en",
+	"zusammenkratzen": "en",
+	"zusammenkuppeln": "eln",
+	"zusammenlassen": "en",
+	"zusammenlaufen": "en",
+	"zusammenleben": "en",
+	"zusammenlegen": "en",
+	"zusammenleimen": "en",
+	"zusammenlesen": "en",
+	"zusammenliegen": "en",
+	"zusammenläppern": "ern",
+	"zusammenlügen": "en",
+	"zusammenmontieren": "en",
+	"zusammennageln": "eln",
+	"zusammennehmen": "en",
+	"zusammennähen": "en",
+	"zusammenpassen": "en",
+	"zusammenpferchen": "en",
+	"zusammenprallen": "en",
+	"zusammenpressen": "en",
+	"zusammenraffen": "en",
+	"zusammenraufen": "en",
+	"zusammenrechnen": "en",
+	"zusammenreihen": "en",
+	"zusammenreimen": "en",
+	"zusammenreissen": "en",
+	"zusammenringeln": "eln",
+	"zusammenrollen": "en",
+	"zusammenrotten": "en",
+	"zusammenrufen": "en",
+	"zusammenräumen": "en",
+	"zusammenrücken": "en",
+	"zusammenscharen": "en",
+	"zusammenscharren": "en",
+	"zusammenschieben": "en",
+	"zusammenschiessen": "en",
+	"zusammenschlagen": "en",
+	"zusammenschliessen": "en",
+	"zusammenschmelzen": "en",
+	"zusammenschnüren": "en",
+	"zusammenschrauben": "en",
+	"zusammenschrecken": "en",
+	"zusammenschreiben": "en",
+	"zusammenschweissen": "en",
+	"zusammenschütten": "en",
+	"zusammensetzen": "en",
+	"zusammensinken": "en",
+	"zusammensitzen": "en",
+	"zusammenspannen": "en",
+	"zusammensparen": "en",
+	"zusammensperren": "en",
+	"zusammenspielen": "en",
+	"zusammenstauchen": "en",
+	"zusammenstecken": "en",
+	"zusammenstehen": "en",
+	"zusammenstellen": "en",
+	"zusammenstimmen": "en",
+	"zusammenstossen": "en",
+	"zusammenstreichen": "en",
+	"zusammenströmen": "en",
+	"zusammenstürzen": "en",
+	"zusammensuchen": "en",
+	"zusammentragen": "en",
+	"zusammentreffen": "en",
+	"zusammentreiben": "en",
+	"zusammentreten": "en",
+	"zusammentrocknen": "en",
+	"zusammentrommeln": "eln",
+	"zusammentun": "n",
+	"zusammenwachsen": "en",
+	"zusammenwerfen": "en",
+	"zusammenwirken": "en",
+	"zusammenziehen": "en",
+	"zusammenzählen": "en",
+	"zuschanzen": "en",
+	"zuschauen": "en",
+	"zuschaufeln": "eln",
+	"zuschicken": "en",
+	"zuschieben": "en",
+	"zuschiessen": "en",
+	"zuschlagen": "en",
+	"zuschliessen": "en",
+	"zuschmeissen": "en",
+	"zuschnallen": "en",
+	"zuschnappen": "en",
+	"zuschneiden": "en",
+	"zuschneien": "en",
+	"zuschnüren": "en",
+	"zuschrauben": "en",
+	"zuschreiben": "en",
+	"zuschustern": "ern",
+	"zuschütten": "en",
+	"zusehen": "en",
+	"zusenden": "en",
+	"zusetzen": "en",
+	"zusichern": "ern",
+	"zusitzen": "en",
+	"zusperren": "en",
+	"zuspielen": "en",
+	"zuspitzen": "en",
+	"zusprechen": "en",
+	"zuspringen": "en",
+	"zustandekommen": "en",
+	"zustechen": "en",
+	"zustecken": "en",
+	"zustehen": "en",
+	"zusteigen": "en",
+	"zustellen": "en",
+	"zusteuern": "ern",
+	"zustimmen": "en",
+	"zustopfen": "en",
+	"zustossen": "en",
+	"zustreben": "en",
+	"zuströmen": "en",
+	"zustutzen": "en",
+	"zustöpseln": "eln",
+	"zustürzen": "en",
+	"zuteilen": "en",
+	"zutragen": "en",
+	"zutrauen": "en",
+	"zutreffen": "en",
+	"zutreiben": "en",
+	"zutrinken": "en",
+	"zuvorkommen": "en",
+	"zuwandern": "ern",
+	"zuwehen": "en",
+	"zuweisen": "en",
+	"zuwenden": "en",
+	"zuwerfen": "en",
+	"zuwiderhandeln": "eln",
+	"zuwiderlaufen": "en",
+	"zuwinken": "en",
+	"zuzahlen": "en",
+	"zuziehen": "en",
+	"zuzwinkern": "ern",
+	"zuzählen": "en",
+	"zwangseinziehen": "en",
+	"zwangsumsiedeln": "eln",
+	"zwangsversteigeren": "en",
+	"zweifeln": "eln",
+	"zweigen": "en",
+	"zwiebeln": "eln",
+	"zwingen": "en",
+	"zwinkern": "ern",
+	"zwirbeln": "eln",
+	"zwischenblenden": "en",
+	"zwischengestalten": "en",
+	"zwischenlanden": "en",
+	"zwitschern": "ern",
+	"zwängen": "en",
+	"zählen": "en",
+	"zähmen": "en",
+	"zäumen": "en",
+	"zäunen": "en",
+	"zögern": "ern",
+	"züchten": "en",
+	"züchtigen": "en",
+	"zücken": "en",
+	"zügeln": "eln",
+	"zündeln": "eln",
+	"zünden": "en",
+	"züngeln": "eln",
+	"zürnen": "en",
+	"ächten": "en",
+	"ächzen": "en",
+	"äffen": "en",
+	"ähneln": "eln",
+	"ändern": "ern",
+	"ängstigen": "en",
+	"ärgern": "ern",
+	"äschern": "ern",
+	"ästen": "en",
+	"ätzen": "en",
+	"äussern": "ern",
+	"öden": "en",
+	"öffnen": "en",
+	"ölen": "en",
+	"übelnehmen": "en",
+	"üben": "en",
+	"überanstrengen": "en",
+	"überarbeiten": "en",
+	"überbauen": "en",
+	"überbeanspruchen": "en",
+	"überbehalten": "en",
+	"überbekommen": "en",
+	"überbelegen": "en",
+	"überbelichten": "en",
+	"überbetonen": "en",
+	"überbewerten": "en",
+	"überbieten": "en",
+	"überblicken": "en",
+	"überbraten": "en",
+	"überbringen": "en",
+	"überbrücken": "en",
+	"überdachen": "en",
+	"überdauern": "ern",
+	"überdecken": "en",
+	"überdehnen": "en",
+	"überdenken": "en",
+	"überdosieren": "en",
+	"überdrehen": "en",
+	"übereignen": "en",
+	"übereinanderschlagen": "en",
+	"übereinkommen": "en",
+	"übereinstimmen": "en",
+	"überessen": "en",
+	"überfahren": "en",
+	"überfallen": "en",
+	"überfischen": "en",
+	"überfliegen": "en",
+	"überfliessen": "en",
+	"überfluten": "en",
+	"überflügeln": "eln",
+	"überfordern": "ern",
+	"überfrachten": "en",
+	"überfragen": "en",
+	"überfremden": "en",
+	"überfressen": "en",
+	"überführen": "en",
+	"überfüllen": "en",
+	"überfüttern": "ern",
+	"übergeben": "en",
+	"übergehen": "en",
+	"übergiessen": "en",
+	"übergreifen": "en",
+	"überhandnehmen": "en",
+	"überheizen": "en",
+	"überhitzen": "en",
+	"überholen": "en",
+	"überhängen": "en",
+	"überhäufen": "en",
+	"überhöhen": "en",
+	"überhören": "en",
+	"überkippen": "en",
+	"überkleben": "en",
+	"überkochen": "en",
+	"überkommen": "en",
+	"überkompensieren": "en",
+	"überkriegen": "en",
+	"überkrusten": "en",
+	"überladen": "en",
+	"überlagern": "ern",
+	"überlappen": "en",
+	"überlassen": "en",
+	"überlasten": "en",
+	"überlaufen": "en",
+	"überleben": "en",
+	"überlegen": "en",
+	"überleiten": "en",
+	"überlesen": "en",
+	"überliefern": "ern",
+	"überliegen": "en",
+	"überlisten": "en",
+	"übermalen": "en",
+	"übermannen": "en",
+	"übermitteln": "eln",
+	"übermüden": "en",
+	"übernachten": "en",
+	"übernehmen": "en",
+	"überordnen": "en",
+	"überprüfen": "en",
+	"überquellen": "en",
+	"überqueren": "en",
+	"überragen": "en",
+	"überraschen": "en",
+	"überreden": "en",
+	"überreichen": "en",
+	"überreizen": "en",
+	"überrennen": "en",
+	"überrollen": "en",
+	"überrumpeln": "eln",
+	"überrunden": "en",
+	"überschatten": "en",
+	"überschauen": "en",
+	"überschlafen": "en",
+	"überschlagen": "en",
+	"überschnappen": "en",
+	"überschneiden": "en",
+	"überschreiben": "en",
+	"überschreiten": "en",
+	"überschwappen": "en",
+	"überschwemmen": "en",
+	"überschätzen": "en",
+	"überschäumen": "en",
+	"überschütten": "en",
+	"übersehen": "en",
+	"übersenden": "en",
+	"übersetzen": "en",
+	"überspannen": "en",
+	"überspielen": "en",
+	"überspinnen": "en",
+	"überspitzen": "en",
+	"überspringen": "en",
+	"übersprudeln": "eln",
+	"überstehen": "en",
+	"übersteigen": "en",
+	"überstellen": "en",
+	"überstimmen": "en",
+	"überstrahlen": "en",
+	"überstrapazieren": "en",
+	"überstreichen": "en",
+	"überstreifen": "en",
+	"überströmen": "en",
+	"überstülpen": "en",
+	"überstürzen": "en",
+	"übersättigen": "en",
+	"übersäuern": "ern",
+	"übertragen": "en",
+	"übertreffen": "en",
+	"übertreiben": "en",
+	"übertreten": "en",
+	"übertrumpfen": "en",
+	"übertölpeln": "eln",
+	"übertönen": "en",
+	"übertünchen": "en",
+	"überversichern": "ern",
+	"übervorteilen": "en",
+	"überwachen": "en",
+	"überwallen": "en",
+	"überweisen": "en",
+	"überwerfen": "en",
+	"überwiegen": "en",
+	"überwinden": "en",
+	"überwintern": "ern",
+	"überwuchern": "ern",
+	"überwältigen": "en",
+	"überwälzen": "en",
+	"überwölben": "en",
+	"überzahlen": "en",
+	"überzeichnen": "en",
+	"überzeugen": "en",
+	"überziehen": "en",
+	"überzählen": "en",
+	"überzüchten": "en",
+	"übrigbehalten": "en",
+	"übrigbleiben": "en",
+	"übriglassen": "en",
+}
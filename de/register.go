@@ -0,0 +1,12 @@
+// Package de contains the German dictionaries. Importing it registers "de"
+// with the lemmatizer, see lemmatizer.Get.
+package de
+
+//go:generate go run ../cmd/dictgen -lang de
+
+import lemmatizer "github.com/lang-ai/simple_lemmatizer"
+
+func init() {
+	lemmatizer.Register("de", Dictionary)
+	lemmatizer.RegisterParadigms("de", Paradigms)
+}
@@ -0,0 +1,54 @@
+package lemmatizer
+
+// derivation strips derivational suffixes (diminutives, augmentatives)
+// from forms missing in the dictionary and retries the lookup with the
+// base form, e.g. perritos -> perros -> perro. Its rules are the
+// [derivation] section of the Rules of the language.
+type derivation struct {
+	// rules remove a suffix and try the stem with each ending, in order.
+	// Endings keep the gender and number of the suffix.
+	rules []rule
+	// alternations undo the spelling changes the suffix forces on the
+	// stem, e.g. poc-o -> poqu-ito
+	alternations []rule
+}
+
+// WithDerivations makes lookups that fail strip diminutive and
+// augmentative suffixes and retry with the base form. It has no effect
+// for languages without derivation rules (only Spanish has them).
+func WithDerivations() Option {
+	return func(l *Lemmatizer) {
+		if r := RulesFor(l.lang); r != nil && len(r.derivation) > 0 {
+			l.derivation = &derivation{rules: r.derivation, alternations: r.alternations}
+		}
+	}
+}
+
+// minStem is the minimum length in runes of a stem after removing a
+// suffix, shorter ones are ignored
+const minStem = 2
+
+// lemma looks up the base forms of the normalized key in dict
+func (d *derivation) lemma(dict entries, key string, pos POS) (string, bool) {
+	for i := range d.rules {
+		r := &d.rules[i]
+		if !r.applies(pos) {
+			continue
+		}
+		stem, ok := r.match(key)
+		if !ok {
+			continue
+		}
+		for _, ending := range r.replacements {
+			if lemma, ok := dict.lemma(stem + ending); ok {
+				return lemma, true
+			}
+			if base, ok := unalternate(d.alternations, stem, r.pattern, ending); ok {
+				if lemma, ok := dict.lemma(base + ending); ok {
+					return lemma, true
+				}
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,56 @@
+package lemmatizer
+
+import (
+	"context"
+	"time"
+)
+
+// memo are the readings of the forms of a document, so each distinct form
+// is looked up once however many times it appears
+type memo map[memoKey][]Analysis
+
+// memoKey is a form and whether it looks like a proper noun where it
+// appears, the only reading that depends on the neighbours of a form
+type memoKey struct {
+	form       string
+	properNoun bool
+}
+
+// LemmatizeDocument lemmatizes the sentences of a document, returning the
+// Tokens Lemmatize returns for each of them. Each distinct form is looked
+// up once and its readings reused, which saves most of the lookups of
+// long documents, where the same words come again and again, above all
+// with fallbacks. It stops when ctx is done, like LemmatizeContext.
+func (p *Pipeline) LemmatizeDocument(ctx context.Context, sentences [][]string, opts ...LookupOption) ([][]Token, error) {
+	o := newLookupOptions(opts)
+	m := make(memo)
+	result := make([][]Token, 0, len(sentences))
+	for _, tokens := range sentences {
+		text, offsets := joinTokens(tokens)
+		sentence, err := p.lemmatize(ctx, o, m, text, tokens, offsets, &runeCounter{text: text})
+		if err != nil {
+			if len(sentence) > 0 {
+				result = append(result, sentence)
+			}
+			return result, err
+		}
+		result = append(result, sentence)
+	}
+	return result, nil
+}
+
+// analyzeMemo returns the readings of tokens[i], those of m when the form
+// was already looked up. Readings cut short by a deadline are not kept,
+// a later lookup with more time may do better.
+func (p *Pipeline) analyzeMemo(m memo, tokens []string, i int, o *lookupOptions, deadline time.Time) ([]Analysis, bool) {
+	key := memoKey{form: tokens[i], properNoun: p.properNouns != nil && p.properNouns.match(tokens, i)}
+	// the readings are copied both ways, the options edit them in place
+	if analyses, ok := m[key]; ok {
+		return append([]Analysis(nil), analyses...), false
+	}
+	analyses, truncated := p.analyze(key.form, key.properNoun, o, deadline)
+	if m != nil && !truncated {
+		m[key] = append([]Analysis(nil), analyses...)
+	}
+	return analyses, truncated
+}